@@ -0,0 +1,4 @@
+# Get backup storage by ID
+data "cherryservers_backup_storage" "backup" {
+  id = "123456"
+}
@@ -0,0 +1,10 @@
+# List BGP sessions of all servers in a project
+data "cherryservers_bgp_sessions" "all" {
+  project_id = 123456
+}
+
+# Get the BGP session of a single server
+data "cherryservers_bgp_sessions" "server" {
+  project_id = 123456
+  server_id  = "654321"
+}
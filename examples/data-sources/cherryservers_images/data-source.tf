@@ -0,0 +1,11 @@
+# List all images available for a plan
+data "cherryservers_images" "all" {
+  plan = "B1-1-1gb-20s-shared"
+}
+
+# Get the most recent Ubuntu image for a plan
+data "cherryservers_images" "ubuntu" {
+  plan        = "B1-1-1gb-20s-shared"
+  family      = "ubuntu"
+  most_recent = true
+}
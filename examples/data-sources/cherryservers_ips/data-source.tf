@@ -0,0 +1,13 @@
+# List all IP addresses in a project
+data "cherryservers_ips" "all" {
+  project_id = 123456
+}
+
+# List unassigned floating IPv4 addresses in a region
+data "cherryservers_ips" "free_floating" {
+  project_id     = 123456
+  type           = "floating-ip"
+  region         = "LT-Siauliai"
+  address_family = 4
+  assigned       = false
+}
@@ -0,0 +1,10 @@
+# List all projects of a team
+data "cherryservers_projects" "all" {
+  team_id = 123456
+}
+
+# List projects with names starting with "prod-"
+data "cherryservers_projects" "production" {
+  team_id    = 123456
+  name_regex = "^prod-"
+}
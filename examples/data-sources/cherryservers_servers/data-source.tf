@@ -0,0 +1,15 @@
+# List all servers in a project
+data "cherryservers_servers" "all" {
+  project_id = 123456
+}
+
+# List active web servers in a region
+data "cherryservers_servers" "web" {
+  project_id     = 123456
+  region         = "LT-Siauliai"
+  state          = "active"
+  hostname_regex = "^web-"
+  tags = {
+    role = "web"
+  }
+}
@@ -0,0 +1,9 @@
+# Get team by ID
+data "cherryservers_team" "by_id" {
+  id = 123456
+}
+
+# Get team by name
+data "cherryservers_team" "by_name" {
+  name = "My Team"
+}
@@ -0,0 +1,3 @@
+# Get all teams available to the API token
+data "cherryservers_teams" "all" {
+}
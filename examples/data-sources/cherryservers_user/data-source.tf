@@ -0,0 +1,9 @@
+# Get the user authenticated by the API token
+data "cherryservers_user" "current" {
+}
+
+# Create a project in the user's first team
+resource "cherryservers_project" "project" {
+  name    = "${data.cherryservers_user.current.first_name}'s project"
+  team_id = data.cherryservers_user.current.teams[0].id
+}
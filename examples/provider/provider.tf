@@ -17,4 +17,54 @@ variable "cherry_api_token" {
 # Configure the Cherry Servers Provider.
 provider "cherryservers" {
   api_token = var.cherry_api_token // API token can be found in Cherry Servers client portal - https://portal.cherryservers.com/settings/api-keys
+}
+
+# Point the provider at a staging API or a local mock server.
+# The API URL can also be set with the CHERRY_API_URL environment variable.
+provider "cherryservers" {
+  alias                = "staging"
+  api_token            = var.cherry_api_token
+  api_url              = "https://api.staging.example.com/v1/"
+  request_timeout      = 30
+  ca_bundle            = file("staging-ca.pem")
+  insecure_skip_verify = false
+}
+
+# Read the API token, API URL and default team ID from the cherryctl "staging" context,
+# stored in ~/.config/cherry/staging.yaml:
+#
+# token: "..."
+# api-url: "https://api.staging.example.com/v1/"
+# team-id: 123456
+provider "cherryservers" {
+  alias   = "profile"
+  profile = "staging"
+}
+
+# Read the same settings from a cherryctl config file at a custom path.
+provider "cherryservers" {
+  alias       = "config_file"
+  config_file = "~/ci/cherry.yaml"
+}
+
+# Tag every server and IP created by this provider.
+provider "cherryservers" {
+  alias = "tagged"
+
+  default_tags {
+    tags = {
+      env         = "production"
+      cost_center = "1234"
+    }
+  }
+}
+
+# Leave tags written by monitoring and billing tooling alone.
+provider "cherryservers" {
+  alias = "ignore_tags"
+
+  ignore_tags {
+    keys         = ["billing_id"]
+    key_prefixes = ["monitoring:"]
+  }
 }
\ No newline at end of file
@@ -0,0 +1,2 @@
+# Import existing backup storage
+terraform import cherryservers_backup_storage.backup 123456
\ No newline at end of file
@@ -0,0 +1,8 @@
+# Create a backup storage for a server
+resource "cherryservers_backup_storage" "backup" {
+  server_id   = cherryservers_server.server.id
+  plan        = "backup_50"
+  region      = "LT-Siauliai"
+  ssh_enabled = true
+  whitelist   = ["1.1.1.1"]
+}
@@ -0,0 +1,2 @@
+# Import existing IP assignment via the assigned IP ID
+terraform import cherryservers_ip_assignment.floating 5fb1a2b3-0000-4c2d-9e0f-123456789abc
\ No newline at end of file
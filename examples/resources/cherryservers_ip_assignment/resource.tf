@@ -0,0 +1,18 @@
+# Assign a floating IP to a server by ID
+resource "cherryservers_ip_assignment" "floating" {
+  ip_id     = cherryservers_ip.floating.id
+  target_id = cherryservers_server.server.id
+}
+
+# Assign a floating IP to a server by hostname
+resource "cherryservers_ip_assignment" "floating_by_hostname" {
+  ip_id           = cherryservers_ip.floating.id
+  target_hostname = "gentle-turtle"
+  project_id      = 123456
+}
+
+# Route a floating IP to another IP
+resource "cherryservers_ip_assignment" "floating_routed" {
+  ip_id        = cherryservers_ip.floating.id
+  target_ip_id = "5fb1a2b3-0000-4c2d-9e0f-123456789abc"
+}
@@ -0,0 +1,12 @@
+# Manage DNS records of several IP addresses at once
+resource "cherryservers_ip_dns_records" "records" {
+  records = {
+    (cherryservers_ip.web.id) = {
+      a_record   = "web"
+      ptr_record = "web.example.com"
+    }
+    (cherryservers_ip.mail.id) = {
+      ptr_record = "mail.example.com"
+    }
+  }
+}
@@ -12,4 +12,11 @@ resource "cherryservers_project" "project_with_bgp" {
   bgp = {
     enabled = "true"
   }
+}
+
+# Create a new Project that can't be destroyed until deletion_protection is switched off
+resource "cherryservers_project" "protected_project" {
+  team_id             = "123456"
+  name                = "Production"
+  deletion_protection = true
 }
\ No newline at end of file
@@ -20,3 +20,22 @@ resource "cherryservers_server" "server" {
     Environment = "Production"
   }
 }
+
+#Power off a server, or reboot it by changing the reboot trigger:
+resource "cherryservers_server" "server" {
+  plan           = "B1-1-1gb-20s-shared"
+  project_id     = 123456
+  region         = "LT-Siauliai"
+  power_state    = "off"
+  reboot_trigger = "2024-01-01"
+}
+
+#Create a new server with BGP enabled:
+resource "cherryservers_server" "server" {
+  plan       = "B1-1-1gb-20s-shared"
+  project_id = 123456
+  region     = "LT-Siauliai"
+  bgp = {
+    enabled = true
+  }
+}
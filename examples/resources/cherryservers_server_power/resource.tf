@@ -0,0 +1,8 @@
+# Reboot a server whenever its user data changes
+resource "cherryservers_server_power" "reboot" {
+  server_id = cherryservers_server.server.id
+  action    = "reboot"
+  triggers = {
+    user_data = cherryservers_server.server.user_data
+  }
+}
@@ -0,0 +1,2 @@
+# Import existing storage volume via its project and storage IDs. The format is: <project_id>,<storage_id>.
+terraform import cherryservers_storage.volume 123456,123456
\ No newline at end of file
@@ -0,0 +1,7 @@
+# Create a new elastic block storage volume
+resource "cherryservers_storage" "volume" {
+  project_id  = 123456
+  region      = "LT-Siauliai"
+  size        = 100
+  description = "Database volume"
+}
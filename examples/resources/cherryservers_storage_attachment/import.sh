@@ -0,0 +1,2 @@
+# Import existing storage attachment via the attached storage ID
+terraform import cherryservers_storage_attachment.volume 123456
\ No newline at end of file
@@ -0,0 +1,12 @@
+# Attach a storage volume to a server by ID
+resource "cherryservers_storage_attachment" "volume" {
+  storage_id = cherryservers_storage.volume.id
+  server_id  = cherryservers_server.server.id
+}
+
+# Attach a storage volume to a server by hostname
+resource "cherryservers_storage_attachment" "volume_by_hostname" {
+  storage_id      = cherryservers_storage.volume.id
+  server_hostname = "gentle-turtle"
+  project_id      = 123456
+}
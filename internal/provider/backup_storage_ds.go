@@ -0,0 +1,204 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &backupStorageDS{}
+	_ datasource.DataSourceWithConfigure = &backupStorageDS{}
+)
+
+func NewBackupStorageDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &backupStorageDS{configurator: configurator}
+	}
+}
+
+type backupStorageDS struct {
+	configurator
+}
+
+type backupStorageDSModel struct {
+	Id            types.String `tfsdk:"id"`
+	ServerId      types.String `tfsdk:"server_id"`
+	Plan          types.String `tfsdk:"plan"`
+	Region        types.String `tfsdk:"region"`
+	SSHEnabled    types.Bool   `tfsdk:"ssh_enabled"`
+	FTPEnabled    types.Bool   `tfsdk:"ftp_enabled"`
+	SMBEnabled    types.Bool   `tfsdk:"smb_enabled"`
+	Whitelist     types.Set    `tfsdk:"whitelist"`
+	Status        types.String `tfsdk:"status"`
+	State         types.String `tfsdk:"state"`
+	PrivateIP     types.String `tfsdk:"private_ip"`
+	PublicIP      types.String `tfsdk:"public_ip"`
+	SizeGigabytes types.Int64  `tfsdk:"size_gigabytes"`
+	UsedGigabytes types.Int64  `tfsdk:"used_gigabytes"`
+	Methods       types.List   `tfsdk:"methods"`
+}
+
+func (d *backupStorageDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_storage"
+}
+
+func (d *backupStorageDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers backup storage data source. This can be used to read server backup storage data.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Backup storage identifier.",
+				Required:    true,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "ID of the server that is backed up to the storage.",
+				Computed:    true,
+			},
+			"plan": schema.StringAttribute{
+				Description: "Slug of the backup storage plan.",
+				Computed:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Slug of the region. Example: LT-Siauliai [See List Regions](https://api.cherryservers.com/doc/#tag/Regions/operation/get-regions).",
+				Computed:    true,
+			},
+			"ssh_enabled": schema.BoolAttribute{
+				Description: "SSH access to the backup storage is enabled.",
+				Computed:    true,
+			},
+			"ftp_enabled": schema.BoolAttribute{
+				Description: "FTP access to the backup storage is enabled.",
+				Computed:    true,
+			},
+			"smb_enabled": schema.BoolAttribute{
+				Description: "SMB access to the backup storage is enabled.",
+				Computed:    true,
+			},
+			"whitelist": schema.SetAttribute{
+				Description: "Set of IP addresses allowed to access the backup storage through the enabled access methods.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the backup storage.",
+				Computed:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the backup storage.",
+				Computed:    true,
+			},
+			"private_ip": schema.StringAttribute{
+				Description: "Private IP address of the backup storage.",
+				Computed:    true,
+			},
+			"public_ip": schema.StringAttribute{
+				Description: "Public IP address of the backup storage.",
+				Computed:    true,
+			},
+			"size_gigabytes": schema.Int64Attribute{
+				Description: "Backup storage size in GB.",
+				Computed:    true,
+			},
+			"used_gigabytes": schema.Int64Attribute{
+				Description: "Used backup storage space in GB.",
+				Computed:    true,
+			},
+			"methods": schema.ListNestedAttribute{
+				Description: "Backup storage access methods.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the access method.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the access method is enabled.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Username for the access method.",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "Host for the access method.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port for the access method.",
+							Computed:    true,
+						},
+						"whitelist": schema.ListAttribute{
+							Description: "IP addresses allowed to use the access method.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *backupStorageDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state backupStorageDSModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupID, err := strconv.Atoi(state.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid backup storage ID", err.Error())
+		return
+	}
+
+	backup, _, err := d.Client().Backups.Get(backupID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("backup storage read failed", err.Error())
+		return
+	}
+
+	var resourceModel backupStorageResourceModel
+	resp.Diagnostics.Append(resourceModel.populateState(ctx, backup)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = resourceModel.Id
+	state.ServerId = resourceModel.ServerId
+	state.Plan = resourceModel.Plan
+	state.Region = resourceModel.Region
+	state.SSHEnabled = resourceModel.SSHEnabled
+	state.FTPEnabled = resourceModel.FTPEnabled
+	state.SMBEnabled = resourceModel.SMBEnabled
+	state.Whitelist = resourceModel.Whitelist
+	state.Status = resourceModel.Status
+	state.State = resourceModel.State
+	state.PrivateIP = resourceModel.PrivateIP
+	state.PublicIP = resourceModel.PublicIP
+	state.SizeGigabytes = resourceModel.SizeGigabytes
+	state.UsedGigabytes = resourceModel.UsedGigabytes
+	state.Methods = resourceModel.Methods
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBackupStorageDS_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const dsName = "data.cherryservers_backup_storage.test_backup"
+	const resourceName = "cherryservers_backup_storage.test_backup"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBackupStorageDSConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dsName, "id", resourceName, "id"),
+					resource.TestCheckResourceAttrPair(dsName, "server_id", resourceName, "server_id"),
+					resource.TestCheckResourceAttrPair(dsName, "plan", resourceName, "plan"),
+					resource.TestCheckResourceAttrPair(dsName, "region", resourceName, "region"),
+					resource.TestCheckResourceAttrPair(dsName, "size_gigabytes", resourceName, "size_gigabytes"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBackupStorageDSConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+%s
+
+data "cherryservers_backup_storage" "test_backup" {
+  id = "${cherryservers_backup_storage.test_backup.id}"
+}
+`, testAccBackupStorageResourceConfig(projectName, teamID, false, `[]`))
+}
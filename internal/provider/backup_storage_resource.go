@@ -0,0 +1,592 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Backup storage access method names, as used by the API.
+const (
+	backupMethodSSH = "ssh"
+	backupMethodFTP = "ftp"
+	backupMethodSMB = "smb"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &backupStorageResource{}
+	_ resource.ResourceWithConfigure   = &backupStorageResource{}
+	_ resource.ResourceWithImportState = &backupStorageResource{}
+	_ resource.ResourceWithModifyPlan  = &backupStorageResource{}
+)
+
+func NewBackupStorageResource() resource.Resource {
+	return &backupStorageResource{}
+}
+
+// backupStorageResource defines the resource implementation.
+type backupStorageResource struct {
+	client *cherrygo.Client
+}
+
+// backupStorageResourceModel describes the resource data model.
+type backupStorageResourceModel struct {
+	Id            types.String   `tfsdk:"id"`
+	ServerId      types.String   `tfsdk:"server_id"`
+	Plan          types.String   `tfsdk:"plan"`
+	Region        types.String   `tfsdk:"region"`
+	SSHEnabled    types.Bool     `tfsdk:"ssh_enabled"`
+	FTPEnabled    types.Bool     `tfsdk:"ftp_enabled"`
+	SMBEnabled    types.Bool     `tfsdk:"smb_enabled"`
+	Whitelist     types.Set      `tfsdk:"whitelist"`
+	Status        types.String   `tfsdk:"status"`
+	State         types.String   `tfsdk:"state"`
+	PrivateIP     types.String   `tfsdk:"private_ip"`
+	PublicIP      types.String   `tfsdk:"public_ip"`
+	SizeGigabytes types.Int64    `tfsdk:"size_gigabytes"`
+	UsedGigabytes types.Int64    `tfsdk:"used_gigabytes"`
+	Methods       types.List     `tfsdk:"methods"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+}
+
+type backupMethodModel struct {
+	Name      types.String `tfsdk:"name"`
+	Enabled   types.Bool   `tfsdk:"enabled"`
+	Username  types.String `tfsdk:"username"`
+	Host      types.String `tfsdk:"host"`
+	Port      types.Int64  `tfsdk:"port"`
+	Whitelist types.List   `tfsdk:"whitelist"`
+}
+
+func (m backupMethodModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"name":      types.StringType,
+		"enabled":   types.BoolType,
+		"username":  types.StringType,
+		"host":      types.StringType,
+		"port":      types.Int64Type,
+		"whitelist": types.ListType{ElemType: types.StringType},
+	}
+}
+
+func (d *backupStorageResourceModel) populateState(ctx context.Context, backup cherrygo.BackupStorage) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	d.Id = types.StringValue(strconv.Itoa(backup.ID))
+	d.ServerId = types.StringValue(strconv.Itoa(backup.AttachedTo.ID))
+	d.Plan = types.StringValue(backup.Plan.Slug)
+	d.Region = types.StringValue(backup.Region.Slug)
+	d.Status = types.StringValue(backup.Status)
+	d.State = types.StringValue(backup.State)
+	d.PrivateIP = types.StringValue(backup.PrivateIP)
+	d.PublicIP = types.StringValue(backup.PublicIP)
+	d.SizeGigabytes = types.Int64Value(int64(backup.SizeGigabytes))
+	d.UsedGigabytes = types.Int64Value(int64(backup.UsedGigabytes))
+
+	d.SSHEnabled = types.BoolValue(false)
+	d.FTPEnabled = types.BoolValue(false)
+	d.SMBEnabled = types.BoolValue(false)
+
+	methods := make([]attr.Value, 0, len(backup.Methods))
+	var whitelist []string
+	for _, method := range backup.Methods {
+		switch strings.ToLower(method.Name) {
+		case backupMethodSSH:
+			d.SSHEnabled = types.BoolValue(method.Enabled)
+		case backupMethodFTP:
+			d.FTPEnabled = types.BoolValue(method.Enabled)
+		case backupMethodSMB:
+			d.SMBEnabled = types.BoolValue(method.Enabled)
+		}
+
+		// All access methods share the same whitelist, so the first enabled one is authoritative.
+		if method.Enabled && whitelist == nil {
+			whitelist = method.WhiteList
+		}
+
+		methodWhitelist, listDiags := types.ListValueFrom(ctx, types.StringType, method.WhiteList)
+		diags.Append(listDiags...)
+
+		methodModel := backupMethodModel{
+			Name:      types.StringValue(method.Name),
+			Enabled:   types.BoolValue(method.Enabled),
+			Username:  types.StringValue(method.Username),
+			Host:      types.StringValue(method.Host),
+			Port:      types.Int64Value(int64(method.Port)),
+			Whitelist: methodWhitelist,
+		}
+
+		methodTf, objDiags := types.ObjectValueFrom(ctx, methodModel.AttributeTypes(), methodModel)
+		diags.Append(objDiags...)
+
+		methods = append(methods, methodTf)
+	}
+
+	methodsTf, methodsDiags := types.ListValue(types.ObjectType{AttrTypes: backupMethodModel{}.AttributeTypes()}, methods)
+	diags.Append(methodsDiags...)
+	d.Methods = methodsTf
+
+	// The API does not keep a whitelist without enabled access methods, so keep the configured one.
+	if whitelist == nil && !d.Whitelist.IsNull() && !d.Whitelist.IsUnknown() {
+		return diags
+	}
+	if whitelist == nil {
+		whitelist = []string{}
+	}
+	whitelistTf, whitelistDiags := types.SetValueFrom(ctx, types.StringType, whitelist)
+	diags.Append(whitelistDiags...)
+	d.Whitelist = whitelistTf
+
+	return diags
+}
+
+// methodRequests builds the access method updates required to match the model.
+func (d *backupStorageResourceModel) methodRequests(ctx context.Context, backupID int) ([]cherrygo.UpdateBackupMethod, diag.Diagnostics) {
+	whitelist := make([]string, 0, len(d.Whitelist.Elements()))
+	diags := d.Whitelist.ElementsAs(ctx, &whitelist, false)
+
+	toggles := []struct {
+		name    string
+		enabled types.Bool
+	}{
+		{backupMethodSSH, d.SSHEnabled},
+		{backupMethodFTP, d.FTPEnabled},
+		{backupMethodSMB, d.SMBEnabled},
+	}
+
+	requests := make([]cherrygo.UpdateBackupMethod, 0, len(toggles))
+	for _, toggle := range toggles {
+		requests = append(requests, cherrygo.UpdateBackupMethod{
+			BackupStorageID:  backupID,
+			BackupMethodName: toggle.name,
+			Enabled:          toggle.enabled.ValueBool(),
+			Whitelist:        whitelist,
+		})
+	}
+
+	return requests, diags
+}
+
+func (r *backupStorageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_backup_storage"
+}
+
+func (r *backupStorageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers backup storage resource. This can be used to create, modify, and delete server backup storages.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Backup storage identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "ID of the server that is backed up to the storage.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"plan": schema.StringAttribute{
+				Description: "Slug of the backup storage plan.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Slug of the region. Example: LT-Siauliai [See List Regions](https://api.cherryservers.com/doc/#tag/Regions/operation/get-regions).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"ssh_enabled": schema.BoolAttribute{
+				Description: "Enable SSH access to the backup storage.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"ftp_enabled": schema.BoolAttribute{
+				Description: "Enable FTP access to the backup storage.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"smb_enabled": schema.BoolAttribute{
+				Description: "Enable SMB access to the backup storage.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"whitelist": schema.SetAttribute{
+				Description: "Set of IP addresses allowed to access the backup storage through the enabled access methods.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Default:     setdefault.StaticValue(types.SetValueMust(types.StringType, []attr.Value{})),
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the backup storage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"state": schema.StringAttribute{
+				Description: "State of the backup storage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"private_ip": schema.StringAttribute{
+				Description: "Private IP address of the backup storage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"public_ip": schema.StringAttribute{
+				Description: "Public IP address of the backup storage.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"size_gigabytes": schema.Int64Attribute{
+				Description: "Backup storage size in GB.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"used_gigabytes": schema.Int64Attribute{
+				Description: "Used backup storage space in GB.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"methods": schema.ListNestedAttribute{
+				Description: "Backup storage access methods.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name of the access method.",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "Whether the access method is enabled.",
+							Computed:    true,
+						},
+						"username": schema.StringAttribute{
+							Description: "Username for the access method.",
+							Computed:    true,
+						},
+						"host": schema.StringAttribute{
+							Description: "Host for the access method.",
+							Computed:    true,
+						},
+						"port": schema.Int64Attribute{
+							Description: "Port for the access method.",
+							Computed:    true,
+						},
+						"whitelist": schema.ListAttribute{
+							Description: "IP addresses allowed to use the access method.",
+							Computed:    true,
+							ElementType: types.StringType,
+						},
+					},
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+// ModifyPlan marks the size and status attributes unknown when the plan changes, since they are kept from state otherwise.
+func (r *backupStorageResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Ignore create and destroy cases.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("plan"), &plan)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("plan"), &state)...)
+	if resp.Diagnostics.HasError() || plan.Equal(state) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("status"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("state"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("size_gigabytes"), types.Int64Unknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("used_gigabytes"), types.Int64Unknown())...)
+}
+
+func (r *backupStorageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *backupStorageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data backupStorageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := strconv.Atoi(data.ServerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid server ID", err.Error())
+		return
+	}
+
+	request := &cherrygo.CreateBackup{
+		ServerID:       serverID,
+		BackupPlanSlug: data.Plan.ValueString(),
+		RegionSlug:     data.Region.ValueString(),
+	}
+
+	backup, _, err := r.client.Backups.Create(request)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create a CherryServers backup storage resource", err.Error())
+		return
+	}
+
+	// Record the backup storage ID right away, so that a failed or interrupted deployment
+	// leaves a tainted resource in the state instead of an untracked backup storage.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(backup.ID))...)
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 30*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = backoff.Retry(
+		func() error {
+			stateOption := cherrygo.GetOptions{Fields: []string{"state"}}
+			b, _, e := r.client.Backups.Get(backup.ID, &stateOption)
+			if e != nil {
+				return backoff.Permanent(e)
+			}
+
+			if b.State == "pending" || b.State == "provisioning" {
+				return errors.New("backup storage is in inactive state")
+			}
+
+			if b.State == "active" {
+				return nil
+			}
+
+			return backoff.Permanent(errors.New("failed to deploy backup storage"))
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(createTimeout),
+			backoff.WithInitialInterval(time.Second*10)), ctx))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to deploy CherryServers backup storage",
+			fmt.Sprintf("backup storage %d: %s", backup.ID, err),
+		)
+		return
+	}
+
+	if !r.updateMethods(ctx, &data, backup.ID, &resp.Diagnostics) {
+		return
+	}
+
+	backup, _, err = r.client.Backups.Get(backup.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to read a CherryServers backup storage resource", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(data.populateState(ctx, backup)...)
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "backup_storage_id", data.Id)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *backupStorageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data backupStorageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid backup storage ID in state", err.Error())
+		return
+	}
+
+	backup, backupGetResp, err := r.client.Backups.Get(backupID, nil)
+	if err != nil {
+		if is404Error(backupGetResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers backup storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.populateState(ctx, backup)...)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *backupStorageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state backupStorageResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupID, err := strconv.Atoi(plan.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid backup storage ID in state", err.Error())
+		return
+	}
+
+	if !plan.Plan.Equal(state.Plan) {
+		request := &cherrygo.UpdateBackupStorage{
+			BackupStorageID: backupID,
+			BackupPlanSlug:  plan.Plan.ValueString(),
+		}
+
+		if _, _, err := r.client.Backups.Update(request); err != nil {
+			resp.Diagnostics.AddError(
+				"unable to update a CherryServers backup storage resource",
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	if !plan.SSHEnabled.Equal(state.SSHEnabled) ||
+		!plan.FTPEnabled.Equal(state.FTPEnabled) ||
+		!plan.SMBEnabled.Equal(state.SMBEnabled) ||
+		!plan.Whitelist.Equal(state.Whitelist) {
+		if !r.updateMethods(ctx, &plan, backupID, &resp.Diagnostics) {
+			return
+		}
+	}
+
+	backup, _, err := r.client.Backups.Get(backupID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers backup storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.populateState(ctx, backup)...)
+
+	ctx = tflog.SetField(ctx, "backup_storage_id", plan.Id)
+	tflog.Trace(ctx, "updated a resource")
+
+	// Save updated plan into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateMethods sets the backup storage access methods to match the model. Returns false on failure.
+func (r *backupStorageResource) updateMethods(ctx context.Context, data *backupStorageResourceModel, backupID int, diags *diag.Diagnostics) bool {
+	requests, d := data.methodRequests(ctx, backupID)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+
+	for i := range requests {
+		if _, _, err := r.client.Backups.UpdateBackupMethod(&requests[i]); err != nil {
+			diags.AddError(
+				"unable to update CherryServers backup storage access method "+requests[i].BackupMethodName,
+				err.Error(),
+			)
+			return false
+		}
+	}
+
+	return true
+}
+
+func (r *backupStorageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data backupStorageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	backupID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid backup storage ID in state", err.Error())
+		return
+	}
+
+	if _, err := r.client.Backups.Delete(backupID); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to delete a CherryServers backup storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "backup_storage_id", data.Id)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *backupStorageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccBackupStorageResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_backup_storage.test_backup"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersBackupStorageDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccBackupStorageResourceConfig(projectName, teamId, false, `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersBackupStorageExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("[0-9]+")),
+					resource.TestCheckResourceAttr(resourceName, "plan", "backup_50"),
+					resource.TestCheckResourceAttr(resourceName, "region", "LT-Siauliai"),
+					resource.TestCheckResourceAttr(resourceName, "ssh_enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttrSet(resourceName, "size_gigabytes"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccBackupStorageResourceConfig(projectName, teamId, true, `["1.1.1.1"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersBackupStorageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "ssh_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "whitelist.#", "1"),
+					resource.TestCheckTypeSetElemAttr(resourceName, "whitelist.*", "1.1.1.1"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccBackupStorageResourceConfig(projectName string, teamID string, sshEnabled bool, whitelist string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_backup_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_backup_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_backup_project.id}"
+}
+
+resource "cherryservers_backup_storage" "test_backup" {
+  server_id = "${cherryservers_server.test_backup_server.id}"
+  plan = "backup_50"
+  region = "LT-Siauliai"
+  ssh_enabled = %t
+  whitelist = %s
+}
+`, projectName, teamID, sshEnabled, whitelist)
+}
+
+func testAccCheckCherryServersBackupStorageExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		backupID, err := testAccGetResourceIdInt(resourceName, "backup storage", s)
+		if err != nil {
+			return err
+		}
+
+		// Try to get the backup storage
+		_, _, err = testCherryGoClient.Backups.Get(backupID, nil)
+		return err
+	}
+}
+
+func testAccCheckCherryServersBackupStorageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cherryservers_backup_storage" {
+			continue
+		}
+
+		backupID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to convert backup storage ID")
+		}
+
+		if _, resp, err := testCherryGoClient.Backups.Get(backupID, nil); err == nil || !is404Error(resp) {
+			return fmt.Errorf("backup storage %d still exists", backupID)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &bgpSessionListDS{}
+	_ datasource.DataSourceWithConfigure = &bgpSessionListDS{}
+)
+
+func NewBGPSessionListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &bgpSessionListDS{configurator: configurator}
+	}
+}
+
+type bgpSessionListDS struct {
+	configurator
+}
+
+type bgpSessionListModel struct {
+	ProjectId types.Int64  `tfsdk:"project_id"`
+	ServerId  types.String `tfsdk:"server_id"`
+	LocalASN  types.Int64  `tfsdk:"local_asn"`
+	Sessions  types.List   `tfsdk:"sessions"`
+}
+
+type bgpSessionModel struct {
+	ServerId       types.String `tfsdk:"server_id"`
+	ServerHostname types.String `tfsdk:"server_hostname"`
+	Region         types.String `tfsdk:"region"`
+	Routers        types.List   `tfsdk:"routers"`
+	PeerASN        types.Int64  `tfsdk:"peer_asn"`
+	Status         types.String `tfsdk:"status"`
+	Available      types.Bool   `tfsdk:"available"`
+}
+
+func bgpSessionAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"server_id":       types.StringType,
+		"server_hostname": types.StringType,
+		"region":          types.StringType,
+		"routers":         types.ListType{ElemType: types.StringType},
+		"peer_asn":        types.Int64Type,
+		"status":          types.StringType,
+		"available":       types.BoolType,
+	}
+}
+
+func (m *bgpSessionModel) populateState(ctx context.Context, server cherrygo.Server) diag.Diagnostics {
+	m.ServerId = types.StringValue(strconv.Itoa(server.ID))
+	m.ServerHostname = types.StringValue(server.Hostname)
+	m.Region = types.StringValue(server.Region.Slug)
+	m.PeerASN = types.Int64Value(int64(server.Region.BGP.Asn))
+	m.Status = types.StringValue(server.BGP.Status)
+	m.Available = types.BoolValue(server.BGP.Available)
+
+	routers, diags := types.ListValueFrom(ctx, types.StringType, server.Region.BGP.Hosts)
+	m.Routers = routers
+
+	return diags
+}
+
+func (d *bgpSessionListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bgp_sessions"
+}
+
+func (d *bgpSessionListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers BGP sessions data source. This can be used to read the BGP peering data of the servers in a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, whose server BGP sessions will be listed.",
+				Required:    true,
+			},
+			"server_id": schema.StringAttribute{
+				Description: "Only list the BGP session of the server with this ID.",
+				Optional:    true,
+			},
+			"local_asn": schema.Int64Attribute{
+				Description: "The local ASN of the project.",
+				Computed:    true,
+			},
+			"sessions": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"server_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "Server identifier.",
+						},
+						"server_hostname": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hostname of the server.",
+						},
+						"region": schema.StringAttribute{
+							Computed:    true,
+							Description: "Slug of the server region.",
+						},
+						"routers": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Addresses of the region BGP routers to peer with.",
+						},
+						"peer_asn": schema.Int64Attribute{
+							Computed:    true,
+							Description: "ASN of the region BGP routers.",
+						},
+						"status": schema.StringAttribute{
+							Computed:    true,
+							Description: "Status of the server BGP session.",
+						},
+						"available": schema.BoolAttribute{
+							Computed:    true,
+							Description: "BGP is available for the server.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "BGP sessions of the servers that have BGP enabled.",
+			},
+		},
+	}
+}
+
+func (d *bgpSessionListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state bgpSessionListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := int(state.ProjectId.ValueInt64())
+	project, _, err := d.Client().Projects.Get(projectID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("project read failed", err.Error())
+		return
+	}
+	state.LocalASN = types.Int64Value(int64(project.Bgp.LocalASN))
+
+	servers, _, err := d.Client().Servers.List(projectID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError("server list failed", err.Error())
+		return
+	}
+
+	sessions := make([]bgpSessionModel, 0, len(servers))
+	for _, server := range servers {
+		if !server.BGP.Enabled {
+			continue
+		}
+		if !state.ServerId.IsNull() && state.ServerId.ValueString() != strconv.Itoa(server.ID) {
+			continue
+		}
+
+		var session bgpSessionModel
+		resp.Diagnostics.Append(session.populateState(ctx, server)...)
+		sessions = append(sessions, session)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: bgpSessionAttributeTypes()}, sessions)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Sessions = list
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
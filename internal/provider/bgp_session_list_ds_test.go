@@ -0,0 +1,59 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccBGPSessionListDS_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const dsName = "data.cherryservers_bgp_sessions.test_sessions"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccBGPSessionListDSConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dsName, "local_asn", "cherryservers_project.test_sessions_project", "bgp.local_asn"),
+					resource.TestCheckResourceAttr(dsName, "sessions.#", "1"),
+					resource.TestCheckResourceAttrPair(dsName, "sessions.0.server_id", "cherryservers_server.test_sessions_server", "id"),
+					resource.TestCheckResourceAttr(dsName, "sessions.0.region", "LT-Siauliai"),
+					resource.TestCheckResourceAttrSet(dsName, "sessions.0.peer_asn"),
+					resource.TestMatchResourceAttr(dsName, "sessions.0.routers.0", ipv4Regex),
+				),
+			},
+		},
+	})
+}
+
+func testAccBGPSessionListDSConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_sessions_project" {
+  name = "%s"
+  team_id = "%s"
+  bgp = {
+    enabled = true
+  }
+}
+
+resource "cherryservers_server" "test_sessions_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_sessions_project.id}"
+  bgp = {
+    enabled = true
+  }
+}
+
+data "cherryservers_bgp_sessions" "test_sessions" {
+  project_id = "${cherryservers_server.test_sessions_server.project_id}"
+}
+`, projectName, teamID)
+}
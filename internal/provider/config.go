@@ -9,17 +9,31 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 )
 
-func DefaultClientConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *cherrygo.Client {
-	client, ok := req.ProviderData.(*cherrygo.Client)
+// resourceData is handed to resources by the provider.
+// It carries provider-wide defaults next to the API client.
+type resourceData struct {
+	client *cherrygo.Client
+	// defaultTeamID is the team ID of the config file profile, 0 if unset.
+	defaultTeamID int64
+	tags          tagsConfig
+}
+
+func resourceDataConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *resourceData {
+	data, ok := req.ProviderData.(*resourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *cherrygo.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.resourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
+		return &resourceData{}
 	}
 
-	return client
+	return data
+}
+
+func DefaultClientConfigure(req resource.ConfigureRequest, resp *resource.ConfigureResponse) *cherrygo.Client {
+	return resourceDataConfigure(req, resp).client
 }
 
 type configurator interface {
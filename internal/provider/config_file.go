@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// configFile is a cherryctl config file. cherryctl stores every named context
+// in its own file, <config dir>/<context>.yaml, for example:
+//
+//	token: "..."
+//	api-url: "https://api.cherryservers.com/v1/"
+//	team-id: 12345
+type configFile struct {
+	Token  string `yaml:"token"`
+	APIURL string `yaml:"api-url"`
+	TeamID int64  `yaml:"team-id"`
+}
+
+// configDir returns the cherryctl config directory, ~/.config/cherry.
+func configDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("unable to find the home directory: %w", err)
+	}
+	return filepath.Join(home, ".config", "cherry"), nil
+}
+
+// profileConfigFilePath returns the path of the cherryctl config file of the named context in dir.
+func profileConfigFilePath(dir, profile string) string {
+	return filepath.Join(dir, profile+".yaml")
+}
+
+// loadProfile reads the config file of the named cherryctl context in dir.
+// If there is no such context, the error lists the available ones.
+func loadProfile(dir, profile string) (configFile, string, error) {
+	path := profileConfigFilePath(dir, profile)
+
+	cfg, err := loadConfigFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		matches, _ := filepath.Glob(profileConfigFilePath(dir, "*"))
+		names := make([]string, 0, len(matches))
+		for _, match := range matches {
+			names = append(names, strings.TrimSuffix(filepath.Base(match), ".yaml"))
+		}
+		sort.Strings(names)
+		return configFile{}, path, fmt.Errorf("profile %q not found in %s, available profiles: %q", profile, dir, names)
+	}
+
+	return cfg, path, err
+}
+
+// loadConfigFile reads the cherryctl config file at path.
+// A leading "~/" in path is expanded to the home directory.
+func loadConfigFile(path string) (configFile, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return configFile{}, fmt.Errorf("unable to expand %q: %w", path, err)
+		}
+		path = filepath.Join(home, rest)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return configFile{}, fmt.Errorf("unable to read config file: %w", err)
+	}
+
+	var cfg configFile
+	if err = yaml.Unmarshal(content, &cfg); err != nil {
+		return configFile{}, fmt.Errorf("unable to parse config file %s: %w", path, err)
+	}
+
+	if cfg.Token == "" {
+		return configFile{}, fmt.Errorf("config file %s has no token", path)
+	}
+
+	return cfg, nil
+}
@@ -0,0 +1,61 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLoadProfile(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"default.yaml": "token: default-token\n",
+		"staging.yaml": "token: staging-token\napi-url: https://api.staging.example.com/v1/\nteam-id: 123\nproject-id: 456\n",
+		"empty.yaml":   "team-id: 456\n",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg, path, err := loadProfile(dir, "staging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := configFile{Token: "staging-token", APIURL: "https://api.staging.example.com/v1/", TeamID: 123}
+	if cfg != want {
+		t.Errorf("config %+v, want %+v", cfg, want)
+	}
+	if path != filepath.Join(dir, "staging.yaml") {
+		t.Errorf("path %s, want the staging context file", path)
+	}
+
+	if cfg, _, err = loadProfile(dir, "default"); err != nil || cfg.Token != "default-token" {
+		t.Errorf("default profile %+v, error %v", cfg, err)
+	}
+
+	if _, _, err = loadProfile(dir, "missing"); err == nil || !strings.Contains(err.Error(), `"staging"`) {
+		t.Errorf("missing profile error %v, want the available profiles listed", err)
+	}
+
+	if _, _, err = loadProfile(dir, "empty"); err == nil {
+		t.Error("expected an error for a profile without a token")
+	}
+}
+
+func TestLoadConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "custom.yaml")
+	if err := os.WriteFile(path, []byte("token: custom-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if cfg, err := loadConfigFile(path); err != nil || cfg.Token != "custom-token" {
+		t.Errorf("config %+v, error %v", cfg, err)
+	}
+
+	if _, err := loadConfigFile(filepath.Join(t.TempDir(), "missing.yaml")); err == nil {
+		t.Error("expected an error for a missing config file")
+	}
+}
@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// deletionProtectionAttribute returns the deletion_protection schema attribute.
+// It is only stored in the Terraform state.
+func deletionProtectionAttribute(resourceType string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: fmt.Sprintf("If true, the %s can't be deleted or replaced. "+
+			"It has to be set to false, and applied, before the %s can be destroyed.", resourceType, resourceType),
+		Optional: true,
+		Computed: true,
+		Default:  booldefault.StaticBool(false),
+	}
+}
+
+// deletionProtected reports whether deletion protection is enabled in the prior state.
+func deletionProtected(ctx context.Context, state tfsdk.State) (bool, diag.Diagnostics) {
+	if state.Raw.IsNull() {
+		return false, nil
+	}
+
+	var deletionProtection types.Bool
+	diags := state.GetAttribute(ctx, path.Root("deletion_protection"), &deletionProtection)
+	return deletionProtection.ValueBool(), diags
+}
+
+// checkDeletionProtection fails destroy plans of a resource with deletion protection enabled.
+// Replacement plans are failed by DenyReplaceIfDeletionProtected.
+func checkDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, resourceType string) {
+	if !req.Plan.Raw.IsNull() {
+		return
+	}
+
+	protected, diags := deletionProtected(ctx, req.State)
+	resp.Diagnostics.Append(diags...)
+	if !protected {
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Deletion protection enabled",
+		fmt.Sprintf("Terraform plans to destroy the %s, but it has deletion protection enabled. "+
+			"Set deletion_protection to false and apply the change first.", resourceType),
+	)
+}
+
+// deletionProtectionEnabled adds an error to resp if deletion protection is enabled.
+// It guards Delete in case the plan check was bypassed.
+func deletionProtectionEnabled(deletionProtection types.Bool, resp *resource.DeleteResponse, resourceType string) bool {
+	if !deletionProtection.ValueBool() {
+		return false
+	}
+
+	resp.Diagnostics.AddError(
+		"Deletion protection enabled",
+		fmt.Sprintf("The %s has deletion protection enabled. Set deletion_protection to false and apply the change first.", resourceType),
+	)
+	return true
+}
+
+var (
+	_ planmodifier.String = denyReplaceIfDeletionProtectedModifier{}
+	_ planmodifier.Int64  = denyReplaceIfDeletionProtectedModifier{}
+	_ planmodifier.Bool   = denyReplaceIfDeletionProtectedModifier{}
+	_ planmodifier.Set    = denyReplaceIfDeletionProtectedModifier{}
+)
+
+// DenyReplaceIfDeletionProtected fails the plan if the value of an attribute that requires
+// replacement changes while deletion protection is enabled.
+func DenyReplaceIfDeletionProtected() denyReplaceIfDeletionProtectedModifier {
+	return denyReplaceIfDeletionProtectedModifier{}
+}
+
+type denyReplaceIfDeletionProtectedModifier struct{}
+
+func (m denyReplaceIfDeletionProtectedModifier) Description(ctx context.Context) string {
+	return "Fails the plan if the attribute changes while deletion protection is enabled."
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	resp.Diagnostics.Append(m.check(ctx, req.Path, req.State, req.Plan, req.StateValue, req.PlanValue)...)
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) PlanModifyInt64(ctx context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	resp.Diagnostics.Append(m.check(ctx, req.Path, req.State, req.Plan, req.StateValue, req.PlanValue)...)
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) PlanModifyBool(ctx context.Context, req planmodifier.BoolRequest, resp *planmodifier.BoolResponse) {
+	resp.Diagnostics.Append(m.check(ctx, req.Path, req.State, req.Plan, req.StateValue, req.PlanValue)...)
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) PlanModifySet(ctx context.Context, req planmodifier.SetRequest, resp *planmodifier.SetResponse) {
+	resp.Diagnostics.Append(m.check(ctx, req.Path, req.State, req.Plan, req.StateValue, req.PlanValue)...)
+}
+
+func (m denyReplaceIfDeletionProtectedModifier) check(ctx context.Context, attrPath path.Path, state tfsdk.State, plan tfsdk.Plan, stateValue, planValue attr.Value) diag.Diagnostics {
+	// Ignore create and destroy cases.
+	if state.Raw.IsNull() || plan.Raw.IsNull() {
+		return nil
+	}
+
+	if planValue.Equal(stateValue) {
+		return nil
+	}
+
+	protected, diags := deletionProtected(ctx, state)
+	if !protected {
+		return diags
+	}
+
+	diags.AddAttributeError(
+		attrPath,
+		"Deletion protection enabled",
+		fmt.Sprintf("Changing %s requires replacing the resource, but it has deletion protection enabled. "+
+			"Set deletion_protection to false and apply the change first.", attrPath),
+	)
+	return diags
+}
@@ -49,6 +49,36 @@ func normalizeServerImage(server *cherrygo.Server, client *cherrygo.Client) erro
 		return err
 	}
 
+	return setServerImageSlug(server, images)
+}
+
+// planImagesCache lists the images of each server plan once,
+// for normalizing the images of many servers.
+type planImagesCache struct {
+	client *cherrygo.Client
+	images map[string][]cherrygo.Image
+}
+
+func newPlanImagesCache(client *cherrygo.Client) *planImagesCache {
+	return &planImagesCache{client: client, images: make(map[string][]cherrygo.Image)}
+}
+
+// normalizeServerImage works like the normalizeServerImage function, but reuses the image lists.
+func (c *planImagesCache) normalizeServerImage(server *cherrygo.Server) error {
+	images, ok := c.images[server.Plan.Slug]
+	if !ok {
+		var err error
+		if images, _, err = c.client.Images.List(server.Plan.Slug, nil); err != nil {
+			return err
+		}
+		c.images[server.Plan.Slug] = images
+	}
+
+	return setServerImageSlug(server, images)
+}
+
+// setServerImageSlug replaces the server image name with its slug from images.
+func setServerImageSlug(server *cherrygo.Server, images []cherrygo.Image) error {
 	for _, image := range images {
 		if image.Name == server.Image {
 			server.Image = image.Slug
@@ -94,3 +124,9 @@ func generatePassword() (string, error) {
 	}
 	return string(password), nil
 }
+
+// normalizePowerState transforms the server power state returned by the API,
+// such as 'Powered on', into the 'on'/'off' values used in the schema.
+func normalizePowerState(powerState string) string {
+	return strings.TrimPrefix(strings.ToLower(powerState), "powered ")
+}
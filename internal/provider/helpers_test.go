@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
+	"slices"
 	"strconv"
 	"testing"
 
@@ -110,3 +113,81 @@ func TestGeneratePassword(t *testing.T) {
 
 	}
 }
+
+func TestPlanImagesCache(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"id": 1, "name": "Ubuntu 24.04 64bit", "slug": "ubuntu_24_04_64bit"}]`))
+	}))
+	defer server.Close()
+
+	client, err := cherrygo.NewClient(cherrygo.WithAuthToken("token"), cherrygo.WithURL(server.URL+"/v1/"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := newPlanImagesCache(client)
+	for _, plan := range []string{"plan-a", "plan-a", "plan-b"} {
+		s := cherrygo.Server{Image: "Ubuntu 24.04 64bit", Plan: cherrygo.Plan{Slug: plan}}
+		if err = cache.normalizeServerImage(&s); err != nil {
+			t.Fatal(err)
+		}
+		if s.Image != "ubuntu_24_04_64bit" {
+			t.Errorf("image %q, want %q", s.Image, "ubuntu_24_04_64bit")
+		}
+	}
+
+	if requests != 2 {
+		t.Errorf("image list requests %d, want one per plan", requests)
+	}
+}
+
+func TestImageVersion(t *testing.T) {
+	tests := []struct {
+		slug    string
+		family  string
+		version []int
+	}{
+		{slug: "ubuntu_22_04_64bit", family: "ubuntu", version: []int{22, 4}},
+		{slug: "ubuntu_24_04_64bit", family: "ubuntu", version: []int{24, 4}},
+		{slug: "windows_2022", family: "windows", version: []int{2022}},
+		{slug: "centos_stream_9_64bit", family: "centos", version: []int{9}},
+		{slug: "debian_bookworm_64bit", family: "debian", version: []int{}},
+		{slug: "custom", family: "custom", version: []int{}},
+	}
+
+	for _, tt := range tests {
+		if got := imageFamily(tt.slug); got != tt.family {
+			t.Errorf("imageFamily(%q) = %q, want %q", tt.slug, got, tt.family)
+		}
+		if got := imageVersion(tt.slug); !slices.Equal(got, tt.version) {
+			t.Errorf("imageVersion(%q) = %v, want %v", tt.slug, got, tt.version)
+		}
+	}
+}
+
+func TestIsNewerImage(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want bool
+	}{
+		{a: "ubuntu_24_04_64bit", b: "ubuntu_22_04_64bit", want: true},
+		{a: "ubuntu_22_04_64bit", b: "ubuntu_24_04_64bit", want: false},
+		{a: "ubuntu_22_10_64bit", b: "ubuntu_22_04_64bit", want: true},
+		{a: "ubuntu_22_04_64bit", b: "ubuntu_22_04_64bit", want: false},
+		{a: "almalinux_9_4", b: "almalinux_9", want: true},
+		{a: "almalinux_9", b: "almalinux_9_4", want: false},
+		{a: "debian_12_64bit", b: "debian_bookworm_64bit", want: true},
+		{a: "debian_bookworm_64bit", b: "debian_12_64bit", want: false},
+		{a: "custom", b: "custom", want: false},
+	}
+
+	for _, tt := range tests {
+		a, b := cherrygo.Image{Slug: tt.a}, cherrygo.Image{Slug: tt.b}
+		if got := isNewerImage(a, b); got != tt.want {
+			t.Errorf("isNewerImage(%q, %q) = %t, want %t", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"encoding/pem"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewHTTPClient(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	cases := []struct {
+		name    string
+		cfg     httpClientConfig
+		wantErr bool
+	}{
+		{name: "untrusted certificate", cfg: httpClientConfig{timeout: time.Second}, wantErr: true},
+		{name: "ca bundle", cfg: httpClientConfig{caBundle: caBundle}},
+		{name: "insecure skip verify", cfg: httpClientConfig{insecureSkipVerify: true}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			client, err := newHTTPClient(c.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if client.Timeout != 0 {
+				t.Errorf("client timeout %s, want it unset in favour of the per-attempt timeout", client.Timeout)
+			}
+
+			resp, err := client.Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err != nil) != c.wantErr {
+				t.Errorf("request error %v, want error %t", err, c.wantErr)
+			}
+		})
+	}
+}
+
+func TestNewHTTPClient_invalidCABundle(t *testing.T) {
+	if _, err := newHTTPClient(httpClientConfig{caBundle: "not a certificate"}); err == nil {
+		t.Error("expected an error for an invalid CA bundle")
+	}
+}
+
+func TestRetryTransport(t *testing.T) {
+	cases := []struct {
+		name      string
+		method    string
+		statuses  []int
+		wantCalls int
+		wantCode  int
+	}{
+		{name: "server error retried", method: http.MethodGet, statuses: []int{502, 503, 200}, wantCalls: 3, wantCode: 200},
+		{name: "retries exhausted", method: http.MethodDelete, statuses: []int{500, 500, 500, 500}, wantCalls: 3, wantCode: 500},
+		{name: "server error not retried for post", method: http.MethodPost, statuses: []int{503, 200}, wantCalls: 1, wantCode: 503},
+		{name: "rate limit retried for post", method: http.MethodPost, statuses: []int{429, 201}, wantCalls: 2, wantCode: 201},
+		{name: "client error not retried", method: http.MethodGet, statuses: []int{404, 200}, wantCalls: 1, wantCode: 404},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method == http.MethodPost && string(body) != "payload" {
+					t.Errorf("request body %q, want %q", body, "payload")
+				}
+				w.WriteHeader(c.statuses[calls])
+				calls++
+			}))
+			defer server.Close()
+
+			client, err := newHTTPClient(httpClientConfig{maxRetries: 2, retryWaitMin: time.Millisecond, retryWaitMax: time.Millisecond})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req, err := http.NewRequest(c.method, server.URL, strings.NewReader("payload"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp.Body.Close()
+
+			if resp.StatusCode != c.wantCode {
+				t.Errorf("status %d, want %d", resp.StatusCode, c.wantCode)
+			}
+			if calls != c.wantCalls {
+				t.Errorf("calls %d, want %d", calls, c.wantCalls)
+			}
+		})
+	}
+}
+
+// The request timeout applies to each attempt, so a retry waiting longer than
+// the timeout still succeeds.
+func TestRetryTransport_attemptTimeout(t *testing.T) {
+	cases := []struct {
+		name  string
+		first func(w http.ResponseWriter)
+	}{
+		{name: "retry wait longer than timeout", first: func(w http.ResponseWriter) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}},
+		{name: "slow attempt retried", first: func(w http.ResponseWriter) {
+			time.Sleep(200 * time.Millisecond)
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			calls := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				calls++
+				if calls == 1 {
+					c.first(w)
+					return
+				}
+				_, _ = w.Write([]byte("ok"))
+			}))
+			defer server.Close()
+
+			client, err := newHTTPClient(httpClientConfig{
+				timeout:      50 * time.Millisecond,
+				maxRetries:   1,
+				retryWaitMin: 100 * time.Millisecond,
+				retryWaitMax: 100 * time.Millisecond,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != http.StatusOK || string(body) != "ok" {
+				t.Errorf("got status %d and body %q, want 200 and %q", resp.StatusCode, body, "ok")
+			}
+			if calls != 2 {
+				t.Errorf("calls %d, want 2", calls)
+			}
+		})
+	}
+}
+
+func TestRetryTransport_backoff(t *testing.T) {
+	transport := &retryTransport{waitMin: time.Second, waitMax: 10 * time.Second}
+
+	retryAfterResp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	longRetryAfterResp := &http.Response{Header: http.Header{"Retry-After": []string{"60"}}}
+
+	cases := []struct {
+		attempt int
+		resp    *http.Response
+		want    time.Duration
+	}{
+		{attempt: 0, want: time.Second},
+		{attempt: 2, want: 4 * time.Second},
+		{attempt: 5, want: 10 * time.Second},
+		{attempt: 0, resp: retryAfterResp, want: 3 * time.Second},
+		{attempt: 0, resp: longRetryAfterResp, want: 10 * time.Second},
+	}
+
+	for _, c := range cases {
+		if got := transport.backoff(c.attempt, c.resp); got != c.want {
+			t.Errorf("backoff(%d) = %s, want %s", c.attempt, got, c.want)
+		}
+	}
+}
+
+func TestTokenBucket(t *testing.T) {
+	bucket := newTokenBucket(2)
+	now := bucket.last
+
+	// The bucket starts full with one second worth of tokens.
+	for i := range 2 {
+		if delay := bucket.reserve(now); delay != 0 {
+			t.Fatalf("request %d delayed by %s, want no delay", i, delay)
+		}
+	}
+
+	if delay := bucket.reserve(now); delay != 500*time.Millisecond {
+		t.Errorf("delay %s, want %s", delay, 500*time.Millisecond)
+	}
+	if delay := bucket.reserve(now); delay != time.Second {
+		t.Errorf("delay %s, want %s", delay, time.Second)
+	}
+
+	// The bucket is refilled, but never over its burst.
+	now = now.Add(10 * time.Second)
+	for i := range 2 {
+		if delay := bucket.reserve(now); delay != 0 {
+			t.Fatalf("request %d delayed by %s after refill, want no delay", i, delay)
+		}
+	}
+	if delay := bucket.reserve(now); delay == 0 {
+		t.Error("request over the burst was not delayed")
+	}
+}
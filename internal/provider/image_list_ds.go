@@ -0,0 +1,235 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &imageListDS{}
+	_ datasource.DataSourceWithConfigure = &imageListDS{}
+)
+
+func NewImageListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &imageListDS{configurator: configurator}
+	}
+}
+
+type imageListDS struct {
+	configurator
+}
+
+type imageListModel struct {
+	Plan       types.String `tfsdk:"plan"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	SlugRegex  types.String `tfsdk:"slug_regex"`
+	Family     types.String `tfsdk:"family"`
+	MostRecent types.Bool   `tfsdk:"most_recent"`
+	Images     types.List   `tfsdk:"images"`
+}
+
+type imageListElementModel struct {
+	ID     types.Int64  `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Slug   types.String `tfsdk:"slug"`
+	Family types.String `tfsdk:"family"`
+}
+
+func imageListElementAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":     types.Int64Type,
+		"name":   types.StringType,
+		"slug":   types.StringType,
+		"family": types.StringType,
+	}
+}
+
+func (m *imageListElementModel) populateState(image cherrygo.Image) {
+	m.ID = types.Int64Value(int64(image.ID))
+	m.Name = types.StringValue(image.Name)
+	m.Slug = types.StringValue(image.Slug)
+	m.Family = types.StringValue(imageFamily(image.Slug))
+}
+
+// imageFamily returns the OS family of an image slug, e.g. 'ubuntu' for 'ubuntu_22_04'.
+func imageFamily(slug string) string {
+	family, _, _ := strings.Cut(slug, "_")
+	return family
+}
+
+// imageVersion returns the numeric version components of an image slug,
+// e.g. [22 4] for 'ubuntu_22_04_64bit'.
+func imageVersion(slug string) []int {
+	parts := strings.Split(slug, "_")
+	version := make([]int, 0, len(parts))
+	for _, part := range parts[1:] {
+		if n, err := strconv.Atoi(part); err == nil {
+			version = append(version, n)
+		}
+	}
+	return version
+}
+
+// isNewerImage reports whether image a has a higher version than image b.
+func isNewerImage(a, b cherrygo.Image) bool {
+	va, vb := imageVersion(a.Slug), imageVersion(b.Slug)
+	for i := 0; i < len(va) && i < len(vb); i++ {
+		if va[i] != vb[i] {
+			return va[i] > vb[i]
+		}
+	}
+	return len(va) > len(vb)
+}
+
+func (d *imageListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_images"
+}
+
+func (d *imageListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers images data source. This can be used to read the operating system images available for a plan.",
+
+		Attributes: map[string]schema.Attribute{
+			"plan": schema.StringAttribute{
+				Description: "Slug of the plan. Example: e5_1620v4. [See List Plans](https://api.cherryservers.com/doc/#tag/Plans/operation/get-plans).",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list images with names matching this regular expression.",
+				Optional:    true,
+			},
+			"slug_regex": schema.StringAttribute{
+				Description: "Only list images with slugs matching this regular expression.",
+				Optional:    true,
+			},
+			"family": schema.StringAttribute{
+				Description: "Only list images of this operating system family, such as 'ubuntu' or 'debian'.",
+				Optional:    true,
+			},
+			"most_recent": schema.BoolAttribute{
+				Description: "If true, only the image with the highest version in the family is listed. Requires family.",
+				Optional:    true,
+				Validators: []validator.Bool{
+					boolvalidator.AlsoRequires(path.MatchRoot("family")),
+				},
+			},
+			"images": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							Computed:    true,
+							Description: "Image ID.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image name.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "Image slug, used as the `image` of `cherryservers_server`.",
+						},
+						"family": schema.StringAttribute{
+							Computed:    true,
+							Description: "Operating system family of the image.",
+						},
+					},
+				},
+				Computed:    true,
+				Description: "Images matching the filters.",
+			},
+		},
+	}
+}
+
+func (d *imageListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state imageListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex, slugRegex *regexp.Regexp
+	var err error
+	if !state.NameRegex.IsNull() {
+		if nameRegex, err = regexp.Compile(state.NameRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddError("invalid name_regex", err.Error())
+			return
+		}
+	}
+	if !state.SlugRegex.IsNull() {
+		if slugRegex, err = regexp.Compile(state.SlugRegex.ValueString()); err != nil {
+			resp.Diagnostics.AddError("invalid slug_regex", err.Error())
+			return
+		}
+	}
+
+	images, _, err := d.Client().Images.List(state.Plan.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("image list failed", err.Error())
+		return
+	}
+
+	filtered := make([]cherrygo.Image, 0, len(images))
+	for _, image := range images {
+		if nameRegex != nil && !nameRegex.MatchString(image.Name) {
+			continue
+		}
+		if slugRegex != nil && !slugRegex.MatchString(image.Slug) {
+			continue
+		}
+		if !state.Family.IsNull() && state.Family.ValueString() != imageFamily(image.Slug) {
+			continue
+		}
+		filtered = append(filtered, image)
+	}
+
+	if state.MostRecent.ValueBool() && len(filtered) > 1 {
+		latest := filtered[0]
+		for _, image := range filtered[1:] {
+			if isNewerImage(image, latest) {
+				latest = image
+			}
+		}
+		filtered = []cherrygo.Image{latest}
+	}
+
+	imageModels := make([]imageListElementModel, len(filtered))
+	for i, v := range filtered {
+		imageModels[i].populateState(v)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: imageListElementAttributeTypes()}, imageModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Images = list
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccImageList(t *testing.T) {
+	const dsName = "data.cherryservers_images.all"
+	const ubuntuDSName = "data.cherryservers_images.ubuntu"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: imagesListConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "images.0.id"),
+					resource.TestCheckResourceAttrSet(dsName, "images.0.name"),
+					resource.TestCheckResourceAttrSet(dsName, "images.0.slug"),
+					resource.TestCheckResourceAttrSet(dsName, "images.0.family"),
+					resource.TestCheckResourceAttr(ubuntuDSName, "images.#", "1"),
+					resource.TestCheckResourceAttr(ubuntuDSName, "images.0.family", "ubuntu"),
+				),
+			},
+		},
+	})
+}
+
+const imagesListConfig string = `
+
+data "cherryservers_images" "all" {
+  plan = "B1-1-1gb-20s-shared"
+}
+
+data "cherryservers_images" "ubuntu" {
+  plan        = "B1-1-1gb-20s-shared"
+  family      = "ubuntu"
+  most_recent = true
+}
+`
@@ -0,0 +1,299 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &ipAssignmentResource{}
+	_ resource.ResourceWithConfigure        = &ipAssignmentResource{}
+	_ resource.ResourceWithImportState      = &ipAssignmentResource{}
+	_ resource.ResourceWithConfigValidators = &ipAssignmentResource{}
+)
+
+func NewIPAssignmentResource() resource.Resource {
+	return &ipAssignmentResource{}
+}
+
+// ipAssignmentResource defines the resource implementation.
+type ipAssignmentResource struct {
+	client *cherrygo.Client
+}
+
+// ipAssignmentResourceModel describes the resource data model.
+type ipAssignmentResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	IpId           types.String `tfsdk:"ip_id"`
+	TargetId       types.String `tfsdk:"target_id"`
+	TargetHostname types.String `tfsdk:"target_hostname"`
+	TargetIPID     types.String `tfsdk:"target_ip_id"`
+	ProjectId      types.Int64  `tfsdk:"project_id"`
+}
+
+func (d *ipAssignmentResourceModel) populateState(ip cherrygo.IPAddress) {
+	d.Id = types.StringValue(ip.ID)
+	d.IpId = types.StringValue(ip.ID)
+	d.TargetId = types.StringValue(strconv.Itoa(ip.TargetedTo.ID))
+	d.TargetHostname = types.StringValue(ip.TargetedTo.Hostname)
+	d.TargetIPID = types.StringValue(ip.RoutedTo.ID)
+}
+
+func (r *ipAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_assignment"
+}
+
+func (r *ipAssignmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("target_id"),
+			path.MatchRoot("target_hostname"),
+			path.MatchRoot("target_ip_id"),
+		),
+	}
+}
+
+func (r *ipAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers IP assignment resource. This can be used to assign an existing IP address to a server " +
+			"or route it to another IP, independently of the IP lifecycle. " +
+			"Do not set the target attributes of the assigned cherryservers_ip resource when using this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "IP assignment identifier. Equal to the assigned IP ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"ip_id": schema.StringAttribute{
+				Description: "ID of the IP address to assign.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_id": schema.StringAttribute{
+				Description: "ID of the server to assign the IP to. " +
+					"Conflicts with target_hostname and target_ip_id.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					UseStateIfNoConfigurationChanges(path.Expressions{
+						path.MatchRoot("target_hostname"),
+						path.MatchRoot("target_ip_id"),
+					}...),
+				},
+			},
+			"target_hostname": schema.StringAttribute{
+				Description: "Hostname of the server to assign the IP to. " +
+					"Conflicts with target_id and target_ip_id. Requires project_id.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("project_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					UseStateIfNoConfigurationChanges(path.Expressions{
+						path.MatchRoot("target_id"),
+						path.MatchRoot("target_ip_id"),
+					}...),
+				},
+			},
+			"target_ip_id": schema.StringAttribute{
+				Description: "Subnet or primary-ip type IP ID to route the IP to. " +
+					"Conflicts with target_id and target_hostname.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					UseStateIfNoConfigurationChanges(path.Expressions{
+						path.MatchRoot("target_id"),
+						path.MatchRoot("target_hostname"),
+					}...),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, used to look up the server by hostname.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *ipAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *ipAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ipAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.assign(data)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to assign a CherryServers IP", err.Error())
+		return
+	}
+
+	data.populateState(ip)
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "ip_id", data.IpId)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ipAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, ipGetResp, err := r.client.IPAddresses.Get(data.Id.ValueString(), nil)
+	if err != nil {
+		if is404Error(ipGetResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers IP assignment",
+			err.Error(),
+		)
+		return
+	}
+
+	// The IP has been unassigned outside of Terraform.
+	if ip.TargetedTo.ID == 0 && ip.RoutedTo.ID == "" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.populateState(ip)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update moves the IP to a new target without unassigning it first.
+func (r *ipAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ipAssignmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ip, err := r.assign(data)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to reassign a CherryServers IP", err.Error())
+		return
+	}
+
+	data.populateState(ip)
+
+	ctx = tflog.SetField(ctx, "ip_id", data.IpId)
+	tflog.Trace(ctx, "updated a resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ipAssignmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if unassignResp, err := r.client.IPAddresses.Unassign(data.Id.ValueString()); err != nil {
+		if is404Error(unassignResp) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to unassign a CherryServers IP",
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "ip_id", data.IpId)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *ipAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ip_id"), req.ID)...)
+}
+
+// assign targets the IP to the configured server or routes it to the configured IP,
+// then reads back the assigned IP.
+func (r *ipAssignmentResource) assign(data ipAssignmentResourceModel) (cherrygo.IPAddress, error) {
+	request := &cherrygo.AssignIPAddress{
+		IpID: data.TargetIPID.ValueString(),
+	}
+
+	if request.IpID == "" {
+		serverID, err := data.getServerId(r)
+		if err != nil {
+			return cherrygo.IPAddress{}, fmt.Errorf("invalid target server ID or hostname: %w", err)
+		}
+		request.ServerID = serverID
+	}
+
+	ipID := data.IpId.ValueString()
+	if _, _, err := r.client.IPAddresses.Assign(ipID, request); err != nil {
+		return cherrygo.IPAddress{}, err
+	}
+
+	ip, _, err := r.client.IPAddresses.Get(ipID, nil)
+	return ip, err
+}
+
+func (d *ipAssignmentResourceModel) getServerId(r *ipAssignmentResource) (int, error) {
+	if d.TargetId.ValueString() != "" {
+		serverID, err := strconv.Atoi(d.TargetId.ValueString())
+		if err != nil {
+			return 0, fmt.Errorf("invalid server ID %q: %w", d.TargetId.ValueString(), err)
+		}
+		return serverID, nil
+	}
+
+	return serverHostnameToID(d.TargetHostname.ValueString(), int(d.ProjectId.ValueInt64()), r.client.Servers)
+}
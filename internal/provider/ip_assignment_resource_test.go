@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIPAssignmentResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_ip_assignment.test_assignment"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIPAssignmentResourceConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersIPAssigned(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "ip_id", "cherryservers_ip.test_assignment_ip", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "target_id", "cherryservers_server.test_assignment_server", "id"),
+					resource.TestMatchResourceAttr(resourceName, "target_hostname", regexp.MustCompile("[a-z]+-[a-z]+")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIPAssignmentResourceConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_assignment_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_assignment_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_assignment_project.id}"
+}
+
+resource "cherryservers_ip" "test_assignment_ip" {
+  project_id = "${cherryservers_project.test_assignment_project.id}"
+  region = "LT-Siauliai"
+}
+
+resource "cherryservers_ip_assignment" "test_assignment" {
+  ip_id = "${cherryservers_ip.test_assignment_ip.id}"
+  target_id = "${cherryservers_server.test_assignment_server.id}"
+}
+`, projectName, teamID)
+}
+
+func testAccCheckCherryServersIPAssigned(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", resourceName)
+		}
+
+		ip, _, err := testCherryGoClient.IPAddresses.Get(rs.Primary.ID, nil)
+		if err != nil {
+			return err
+		}
+
+		if ip.TargetedTo.ID == 0 && ip.RoutedTo.ID == "" {
+			return fmt.Errorf("ip %s is not assigned", rs.Primary.ID)
+		}
+		return nil
+	}
+}
@@ -143,7 +143,7 @@ func (d *ipDataSource) Configure(ctx context.Context, req datasource.ConfigureRe
 }
 
 func (d *ipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	var state ipResourceModel
+	var state ipModel
 
 	// Read Terraform configuration data into the model
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
@@ -179,7 +179,7 @@ func (d *ipDataSource) Read(ctx context.Context, req datasource.ReadRequest, res
 	}
 
 	state.ProjectId = types.Int64Value(int64(ip.Project.ID))
-	state.populateState(ip, ctx, resp.Diagnostics)
+	resp.Diagnostics.Append(state.populateState(ip, ctx)...)
 
 	// Write logs using the tflog package
 	tflog.Trace(ctx, "read a data source")
@@ -0,0 +1,353 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// aRecordZone is the zone the API appends to relative A record names.
+const aRecordZone = ".cloud.cherryservers.net."
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &ipDNSRecordsResource{}
+	_ resource.ResourceWithConfigure  = &ipDNSRecordsResource{}
+	_ resource.ResourceWithModifyPlan = &ipDNSRecordsResource{}
+)
+
+func NewIPDNSRecordsResource() resource.Resource {
+	return &ipDNSRecordsResource{}
+}
+
+// ipDNSRecordsResource defines the resource implementation.
+type ipDNSRecordsResource struct {
+	client *cherrygo.Client
+}
+
+// ipDNSRecordsResourceModel describes the resource data model.
+type ipDNSRecordsResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	Records types.Map    `tfsdk:"records"`
+}
+
+// recordsID returns the identifier of the records, the sorted and comma-joined IP IDs.
+func recordsID(records types.Map) types.String {
+	if records.IsUnknown() {
+		return types.StringUnknown()
+	}
+
+	ipIDs := make([]string, 0, len(records.Elements()))
+	for ipID := range records.Elements() {
+		ipIDs = append(ipIDs, ipID)
+	}
+	sort.Strings(ipIDs)
+
+	return types.StringValue(strings.Join(ipIDs, ","))
+}
+
+type ipDNSRecordModel struct {
+	ARecord            types.String `tfsdk:"a_record"`
+	ARecordEffective   types.String `tfsdk:"a_record_effective"`
+	PTRRecord          types.String `tfsdk:"ptr_record"`
+	PTRRecordEffective types.String `tfsdk:"ptr_record_effective"`
+}
+
+func ipDNSRecordAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"a_record":             types.StringType,
+		"a_record_effective":   types.StringType,
+		"ptr_record":           types.StringType,
+		"ptr_record_effective": types.StringType,
+	}
+}
+
+// populateState sets the effective records from the API. Configured records that
+// no longer match the effective ones are replaced, so that drift shows up in the plan.
+func (d *ipDNSRecordModel) populateState(ip cherrygo.IPAddress) {
+	d.ARecordEffective = types.StringValue(ip.ARecord)
+	d.PTRRecordEffective = types.StringValue(ip.PtrRecord)
+
+	if !d.ARecord.IsNull() && !aRecordMatches(d.ARecord.ValueString(), ip.ARecord) {
+		d.ARecord = types.StringValue(strings.TrimSuffix(ip.ARecord, aRecordZone))
+	}
+	if !d.PTRRecord.IsNull() && !ptrRecordMatches(d.PTRRecord.ValueString(), ip.PtrRecord) {
+		d.PTRRecord = types.StringValue(strings.TrimSuffix(ip.PtrRecord, "."))
+	}
+}
+
+// changes returns the update request for the records that differ from the effective ones,
+// or nil if nothing has changed. The API returns error 500 if a ptr_record is re-sent unchanged.
+func (d *ipDNSRecordModel) changes(ip cherrygo.IPAddress) *cherrygo.UpdateIPAddress {
+	var request cherrygo.UpdateIPAddress
+	if !d.ARecord.IsNull() && !aRecordMatches(d.ARecord.ValueString(), ip.ARecord) {
+		request.ARecord = d.ARecord.ValueString()
+	}
+	if !d.PTRRecord.IsNull() && !ptrRecordMatches(d.PTRRecord.ValueString(), ip.PtrRecord) {
+		request.PtrRecord = d.PTRRecord.ValueString()
+	}
+
+	if request.ARecord == "" && request.PtrRecord == "" {
+		return nil
+	}
+	return &request
+}
+
+func aRecordMatches(record, effective string) bool {
+	return strings.TrimSuffix(record, aRecordZone) == strings.TrimSuffix(effective, aRecordZone)
+}
+
+func ptrRecordMatches(record, effective string) bool {
+	return strings.TrimSuffix(record, ".") == strings.TrimSuffix(effective, ".")
+}
+
+func (r *ipDNSRecordsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ip_dns_records"
+}
+
+func (r *ipDNSRecordsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers IP DNS records resource. This can be used to manage A and PTR records for many IP addresses at once. " +
+			"Only records that differ from the effective ones are sent to the API. " +
+			"The API can't clear records, so removing an IP from the map or destroying the resource leaves its records in place. " +
+			"Do not set a_record or ptr_record on the managed cherryservers_ip resources when using this resource.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "DNS records identifier.",
+				Computed:    true,
+			},
+			"records": schema.MapNestedAttribute{
+				Description: "Map of IP IDs to their DNS records.",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"a_record": schema.StringAttribute{
+							Description: "Relative DNS name for the IP address. Resulting FQDN will be '<relative-dns-name>.cloud.cherryservers.net' and must be globally unique.",
+							Optional:    true,
+						},
+						"a_record_effective": schema.StringAttribute{
+							Description: "Relative DNS name for the IP address. Resulting FQDN will be '<relative-dns-name>.cloud.cherryservers.net' and must be globally unique. " +
+								"API return value.",
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								UseStateIfNoConfigurationChanges(path.MatchRelative().AtParent().AtName("a_record")),
+							},
+						},
+						"ptr_record": schema.StringAttribute{
+							Description: "Reverse DNS name for the IP address.",
+							Optional:    true,
+						},
+						"ptr_record_effective": schema.StringAttribute{
+							Description: "Reverse DNS name for the IP address. API return value.",
+							Computed:    true,
+							PlanModifiers: []planmodifier.String{
+								UseStateIfNoConfigurationChanges(path.MatchRelative().AtParent().AtName("ptr_record")),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ModifyPlan sets id from the planned IP IDs, so that it follows changes to the records keys.
+func (r *ipDNSRecordsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Ignore destroy cases.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var records types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("records"), &records)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), recordsID(records))...)
+}
+
+func (r *ipDNSRecordsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *ipDNSRecordsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ipDNSRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = recordsID(data.Records)
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "ip_dns_records_id", data.Id)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipDNSRecordsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ipDNSRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records := make(map[string]ipDNSRecordModel, len(data.Records.Elements()))
+	resp.Diagnostics.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for ipID, record := range records {
+		ip, ipGetResp, err := r.client.IPAddresses.Get(ipID, nil)
+		if err != nil {
+			// The IP has been deleted outside of Terraform.
+			if is404Error(ipGetResp) {
+				delete(records, ipID)
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"unable to read a CherryServers IP DNS records resource",
+				fmt.Sprintf("IP %s: %s", ipID, err.Error()),
+			)
+			return
+		}
+
+		record.populateState(ip)
+		records[ipID] = record
+	}
+
+	if len(records) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.Records, diags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: ipDNSRecordAttributeTypes()}, records)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ipDNSRecordsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ipDNSRecordsResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = recordsID(data.Records)
+
+	ctx = tflog.SetField(ctx, "ip_dns_records_id", data.Id)
+	tflog.Trace(ctx, "updated a resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from the state, because the API can't clear DNS records.
+func (r *ipDNSRecordsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ipDNSRecordsResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "ip_dns_records_id", data.Id)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// apply sends the changed records of every IP and populates the effective ones.
+func (r *ipDNSRecordsResource) apply(ctx context.Context, data *ipDNSRecordsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	records := make(map[string]ipDNSRecordModel, len(data.Records.Elements()))
+	diags.Append(data.Records.ElementsAs(ctx, &records, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for ipID, record := range records {
+		ip, _, err := r.client.IPAddresses.Get(ipID, nil)
+		if err != nil {
+			diags.AddError("unable to read a CherryServers IP", fmt.Sprintf("IP %s: %s", ipID, err.Error()))
+			return diags
+		}
+
+		if request := record.changes(ip); request != nil {
+			tflog.Debug(ctx, "updating IP DNS records", map[string]interface{}{"ip_id": ipID})
+			if _, _, err = r.client.IPAddresses.Update(ipID, request); err != nil {
+				diags.AddError("unable to update CherryServers IP DNS records", fmt.Sprintf("IP %s: %s", ipID, err.Error()))
+				return diags
+			}
+
+			ip, _, err = r.client.IPAddresses.Get(ipID, nil)
+			if err != nil {
+				diags.AddError("unable to read a CherryServers IP", fmt.Sprintf("IP %s: %s", ipID, err.Error()))
+				return diags
+			}
+		}
+
+		record.ARecordEffective = types.StringValue(ip.ARecord)
+		record.PTRRecordEffective = types.StringValue(ip.PtrRecord)
+		records[ipID] = record
+	}
+
+	var mapDiags diag.Diagnostics
+	data.Records, mapDiags = types.MapValueFrom(ctx, types.ObjectType{AttrTypes: ipDNSRecordAttributeTypes()}, records)
+	diags.Append(mapDiags...)
+
+	return diags
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccIPDNSRecordsResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	aRecord := generateAlphaString(8)
+	aRecordUpdated := generateAlphaString(8)
+	const resourceName = "cherryservers_ip_dns_records.test_dns_records"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccIPDNSRecordsResourceConfig(projectName, teamId, aRecord),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "records.%", "2"),
+					testAccCheckIPDNSRecord(resourceName, "cherryservers_ip.test_dns_records_ip_a", "a_record_effective", aRecord+".cloud.cherryservers.net."),
+					testAccCheckIPDNSRecord(resourceName, "cherryservers_ip.test_dns_records_ip_a", "ptr_record_effective", "test."),
+					testAccCheckIPDNSRecord(resourceName, "cherryservers_ip.test_dns_records_ip_b", "ptr_record_effective", "test."),
+				),
+			},
+			// Update and Read testing, only the A record of one IP is sent
+			{
+				Config: testAccIPDNSRecordsResourceConfig(projectName, teamId, aRecordUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIPDNSRecord(resourceName, "cherryservers_ip.test_dns_records_ip_a", "a_record_effective", aRecordUpdated+".cloud.cherryservers.net."),
+					testAccCheckIPDNSRecord(resourceName, "cherryservers_ip.test_dns_records_ip_a", "ptr_record_effective", "test."),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccIPDNSRecordsResourceConfig(projectName, teamID, aRecord string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_dns_records_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_ip" "test_dns_records_ip_a" {
+  project_id = "${cherryservers_project.test_dns_records_project.id}"
+  region = "LT-Siauliai"
+}
+
+resource "cherryservers_ip" "test_dns_records_ip_b" {
+  project_id = "${cherryservers_project.test_dns_records_project.id}"
+  region = "LT-Siauliai"
+}
+
+resource "cherryservers_ip_dns_records" "test_dns_records" {
+  records = {
+    (cherryservers_ip.test_dns_records_ip_a.id) = {
+      a_record = "%s"
+      ptr_record = "test"
+    }
+    (cherryservers_ip.test_dns_records_ip_b.id) = {
+      ptr_record = "test"
+    }
+  }
+}
+`, projectName, teamID, aRecord)
+}
+
+// testAccCheckIPDNSRecord checks a record attribute of the map entry keyed by the ID of ipResourceName.
+func testAccCheckIPDNSRecord(resourceName, ipResourceName, attribute, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		ip, ok := s.RootModule().Resources[ipResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", ipResourceName)
+		}
+
+		return resource.TestCheckResourceAttr(resourceName, fmt.Sprintf("records.%s.%s", ip.Primary.ID, attribute), value)(s)
+	}
+}
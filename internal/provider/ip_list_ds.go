@@ -0,0 +1,274 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ipListDS{}
+	_ datasource.DataSourceWithConfigure = &ipListDS{}
+)
+
+func NewIPListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &ipListDS{configurator: configurator}
+	}
+}
+
+type ipListDS struct {
+	configurator
+}
+
+type ipListModel struct {
+	ProjectId     types.Int64  `tfsdk:"project_id"`
+	Type          types.String `tfsdk:"type"`
+	Region        types.String `tfsdk:"region"`
+	AddressFamily types.Int64  `tfsdk:"address_family"`
+	Assigned      types.Bool   `tfsdk:"assigned"`
+	Tags          types.Map    `tfsdk:"tags"`
+	IPs           types.List   `tfsdk:"ips"`
+}
+
+func ipAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":                   types.StringType,
+		"project_id":           types.Int64Type,
+		"region":               types.StringType,
+		"target_id":            types.StringType,
+		"target_hostname":      types.StringType,
+		"target_ip_id":         types.StringType,
+		"a_record":             types.StringType,
+		"a_record_effective":   types.StringType,
+		"ptr_record":           types.StringType,
+		"ptr_record_effective": types.StringType,
+		"address":              types.StringType,
+		"address_family":       types.Int64Type,
+		"cidr":                 types.StringType,
+		"gateway":              types.StringType,
+		"type":                 types.StringType,
+		"tags":                 types.MapType{ElemType: types.StringType},
+	}
+}
+
+// matches reports whether the IP address satisfies all the configured filters.
+func (m *ipListModel) matches(ctx context.Context, ip cherrygo.IPAddress) (bool, diag.Diagnostics) {
+	if !m.Type.IsNull() && m.Type.ValueString() != ip.Type {
+		return false, nil
+	}
+	if !m.Region.IsNull() && m.Region.ValueString() != ip.Region.Slug {
+		return false, nil
+	}
+	if !m.AddressFamily.IsNull() && m.AddressFamily.ValueInt64() != int64(ip.AddressFamily) {
+		return false, nil
+	}
+	if !m.Assigned.IsNull() {
+		assigned := ip.TargetedTo.ID != 0 || ip.RoutedTo.ID != ""
+		if m.Assigned.ValueBool() != assigned {
+			return false, nil
+		}
+	}
+
+	if !m.Tags.IsNull() {
+		tags := make(map[string]string, len(m.Tags.Elements()))
+		if diags := m.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return false, diags
+		}
+
+		for k, v := range tags {
+			if ip.Tags[k] != v {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (d *ipListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_ips"
+}
+
+func (d *ipListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers IPs data source. This can be used to list and filter IP addresses in a project.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, whose IP addresses will be listed.",
+				Required:    true,
+			},
+			"type": schema.StringAttribute{
+				Description: "Only list IP addresses of this type. " +
+					"One of 'primary-ip', 'floating-ip', 'subnet' or 'private-ip'.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("primary-ip", "floating-ip", "subnet", "private-ip"),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Only list IP addresses in the region with this slug.",
+				Optional:    true,
+			},
+			"address_family": schema.Int64Attribute{
+				Description: "Only list IP addresses of this address family, 4 or 6.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.OneOf(4, 6),
+				},
+			},
+			"assigned": schema.BoolAttribute{
+				Description: "If true, only list IP addresses that are attached to a server or routed to another IP. " +
+					"If false, only list unattached IP addresses.",
+				Optional: true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only list IP addresses that have all of these key/value tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"ips": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: ipListElementSchema(),
+				},
+				Computed:    true,
+				Description: "IP addresses matching the filters.",
+			},
+		},
+	}
+}
+
+func ipListElementSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "IP identifier.",
+			Computed:    true,
+		},
+		"project_id": schema.Int64Attribute{
+			Description: "CherryServers project id, associated with the IP.",
+			Computed:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "Slug of the region. Example: LT-Siauliai [See List Regions](https://api.cherryservers.com/doc/#tag/Regions/operation/get-regions).",
+			Computed:    true,
+		},
+		"target_id": schema.StringAttribute{
+			Description: "The ID of the server to which the IP is attached.",
+			Computed:    true,
+		},
+		"target_hostname": schema.StringAttribute{
+			Description: "The hostname of the server to which the IP is attached.",
+			Computed:    true,
+		},
+		"target_ip_id": schema.StringAttribute{
+			Description: "Subnet or primary-ip type IP ID the IP is targeted to.",
+			Computed:    true,
+		},
+		"a_record": schema.StringAttribute{
+			Description: "Relative DNS name for the IP address. Resulting FQDN will be '<relative-dns-name>.cloud.cherryservers.net' and must be globally unique.",
+			Computed:    true,
+		},
+		"a_record_effective": schema.StringAttribute{
+			Description: "Relative DNS name for the IP address. Resulting FQDN will be '<relative-dns-name>.cloud.cherryservers.net' and must be globally unique." +
+				"API return value.",
+			Computed: true,
+		},
+		"ptr_record": schema.StringAttribute{
+			Description: "Reverse DNS name for the IP address.",
+			Computed:    true,
+		},
+		"ptr_record_effective": schema.StringAttribute{
+			Description: "Reverse DNS name for the IP address. API return value.",
+			Computed:    true,
+		},
+		"address": schema.StringAttribute{
+			Description: "The IP address in canonical format used in the reverse DNS record.",
+			Computed:    true,
+		},
+		"address_family": schema.Int64Attribute{
+			Description: "IP address family IPv4 or IPv6.",
+			Computed:    true,
+		},
+		"cidr": schema.StringAttribute{
+			Description: "The CIDR block of the IP.",
+			Computed:    true,
+		},
+		"gateway": schema.StringAttribute{
+			Description: "The gateway IP address.",
+			Computed:    true,
+		},
+		"type": schema.StringAttribute{
+			Description: "The type of IP address.",
+			Computed:    true,
+		},
+		"tags": schema.MapAttribute{
+			Description: "Key/value metadata for IP tagging.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func (d *ipListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state ipListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ips, _, err := d.Client().IPAddresses.List(int(state.ProjectId.ValueInt64()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("IP address list failed", err.Error())
+		return
+	}
+
+	ipModels := make([]ipModel, 0, len(ips))
+	for _, ip := range ips {
+		ok, diags := state.matches(ctx, ip)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !ok {
+			continue
+		}
+
+		var model ipModel
+		resp.Diagnostics.Append(model.populateState(ip, ctx)...)
+		model.ARecord = model.ARecordEffective
+		model.PTRRecord = model.PTRRecordEffective
+		ipModels = append(ipModels, model)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ipAttributeTypes()}, ipModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.IPs = list
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccIPListDS_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const dsName = "data.cherryservers_ips.test_ips"
+	const ipName = "cherryservers_ip.test_ips_ip"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccIPListDSConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "ips.#", "1"),
+					resource.TestCheckResourceAttrPair(dsName, "ips.0.id", ipName, "id"),
+					resource.TestCheckResourceAttrPair(dsName, "ips.0.address", ipName, "address"),
+					resource.TestCheckResourceAttr(dsName, "ips.0.type", "floating-ip"),
+					resource.TestCheckResourceAttr(dsName, "ips.0.tags.env", "test"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPListDSConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_ips_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_ip" "test_ips_ip" {
+  project_id = "${cherryservers_project.test_ips_project.id}"
+  region = "LT-Siauliai"
+  tags = {
+    env = "test"
+  }
+}
+
+data "cherryservers_ips" "test_ips" {
+  project_id = "${cherryservers_ip.test_ips_ip.project_id}"
+  type = "floating-ip"
+  region = "LT-Siauliai"
+  address_family = 4
+  assigned = false
+  tags = {
+    env = "test"
+  }
+}
+`, projectName, teamID)
+}
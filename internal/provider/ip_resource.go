@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &ipResource{}
 	_ resource.ResourceWithConfigure   = &ipResource{}
 	_ resource.ResourceWithImportState = &ipResource{}
+	_ resource.ResourceWithModifyPlan  = &ipResource{}
 )
 
 func NewIpResource() resource.Resource {
@@ -34,10 +35,18 @@ func NewIpResource() resource.Resource {
 // ipResource defines the resource implementation.
 type ipResource struct {
 	client *cherrygo.Client
+	tags   tagsConfig
 }
 
 // ipResourceModel describes the resource data model.
 type ipResourceModel struct {
+	ipModel
+	TagsAll            types.Map  `tfsdk:"tags_all"`
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
+}
+
+// ipModel describes the IP attributes shared with the data sources.
+type ipModel struct {
 	Id                 types.String `tfsdk:"id"`
 	ProjectId          types.Int64  `tfsdk:"project_id"`
 	Region             types.String `tfsdk:"region"`
@@ -56,7 +65,7 @@ type ipResourceModel struct {
 	Tags               types.Map    `tfsdk:"tags"`
 }
 
-func (d *ipResourceModel) populateState(ip cherrygo.IPAddress, ctx context.Context, diags diag.Diagnostics) {
+func (d *ipModel) populateState(ip cherrygo.IPAddress, ctx context.Context) diag.Diagnostics {
 	d.Id = types.StringValue(ip.ID)
 	d.ProjectId = types.Int64Value(int64(ip.Project.ID))
 	d.Region = types.StringValue(ip.Region.Slug)
@@ -74,7 +83,7 @@ func (d *ipResourceModel) populateState(ip cherrygo.IPAddress, ctx context.Conte
 	tags, mapDiag := types.MapValueFrom(ctx, types.StringType, ip.Tags)
 	d.Tags = tags
 
-	diags.Append(mapDiag...)
+	return mapDiag
 }
 
 func (r *ipResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -84,7 +93,10 @@ func (r *ipResource) Metadata(ctx context.Context, req resource.MetadataRequest,
 func (r *ipResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
-		Description: "Provides a CherryServers IP resource. This can be used to create, modify, and delete IP addresses.",
+		Description: "Provides a CherryServers IP resource. This can be used to create, modify, and delete IP addresses. " +
+			"Only single floating IP addresses can be requested, since the API IP creation endpoint doesn't accept a prefix length. " +
+			"Routed subnets (such as a /29) can't be allocated through the API or the provider. " +
+			"Existing subnets can be read with the cherryservers_ip and cherryservers_ips data sources.",
 
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -99,6 +111,7 @@ func (r *ipResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 				Required:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"region": schema.StringAttribute{
@@ -106,6 +119,7 @@ func (r *ipResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"target_id": schema.StringAttribute{
@@ -224,17 +238,58 @@ func (r *ipResource) Schema(ctx context.Context, req resource.SchemaRequest, res
 				Default:     mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{})),
 				Computed:    true,
 			},
+			"deletion_protection": deletionProtectionAttribute("IP"),
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the IP, including provider default tags.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 		},
 	}
 }
 
+// ModifyPlan guards deletion protection and shows the provider default tags merged into tags_all.
+func (r *ipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "IP")
+
+	// Ignore destroy cases.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var tags types.Map
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tags"), &tags)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tagsAll, diags := r.tags.planTagsAll(ctx, tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("tags_all"), tagsAll)...)
+}
+
 func (r *ipResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	r.client = DefaultClientConfigure(req, resp)
+	data := resourceDataConfigure(req, resp)
+	r.client = data.client
+	r.tags = data.tags
+}
+
+// populateWithTags populates the model from the API, keeping the provider default tags out of tags.
+func (r *ipResource) populateWithTags(ctx context.Context, data *ipResourceModel, ip cherrygo.IPAddress) diag.Diagnostics {
+	tags, tagsAll, diags := r.tags.stateTags(ctx, ip.Tags, data.Tags)
+	diags.Append(data.populateState(ip, ctx)...)
+	data.Tags, data.TagsAll = tags, tagsAll
+
+	return diags
 }
 
 func (r *ipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -247,6 +302,8 @@ func (r *ipResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
+	// The create IP endpoint has no prefix length or size field, so it always allocates a single
+	// floating address. Routed subnets are provisioned outside the API and can't be requested here.
 	request := &cherrygo.CreateIPAddress{
 		Region:    data.Region.ValueString(),
 		PtrRecord: data.PTRRecord.ValueString(),
@@ -254,8 +311,7 @@ func (r *ipResource) Create(ctx context.Context, req resource.CreateRequest, res
 		RoutedTo:  data.TargetIPID.ValueString(),
 	}
 
-	tagsMap := make(map[string]string, len(data.Tags.Elements()))
-	diags := data.Tags.ElementsAs(ctx, &tagsMap, false)
+	tagsMap, diags := r.tags.requestTags(ctx, data.Tags, nil)
 	resp.Diagnostics.Append(diags...)
 
 	request.Tags = &tagsMap
@@ -282,7 +338,7 @@ func (r *ipResource) Create(ctx context.Context, req resource.CreateRequest, res
 		return
 	}
 
-	data.populateState(ip, ctx, resp.Diagnostics)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &data, ip)...)
 
 	// Write logs using the tflog package
 	tflog.SetField(ctx, "ip_id", data.Id)
@@ -302,6 +358,11 @@ func (r *ipResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 		return
 	}
 
+	// Deletion protection is not set on import.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	ip, ipGetResp, err := r.client.IPAddresses.Get(data.Id.ValueString(), nil)
 	if err != nil {
 		if is404Error(ipGetResp) {
@@ -315,7 +376,7 @@ func (r *ipResource) Read(ctx context.Context, req resource.ReadRequest, resp *r
 		return
 	}
 
-	data.populateState(ip, ctx, resp.Diagnostics)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &data, ip)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -348,8 +409,18 @@ func (r *ipResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		request.PtrRecord = data.PTRRecord.ValueString()
 	}
 
-	tagsMap := make(map[string]string, len(data.Tags.Elements()))
-	diags := data.Tags.ElementsAs(ctx, &tagsMap, false)
+	// The API replaces all tags, so read the ignored ones to preserve them.
+	var currentTags map[string]string
+	if r.tags.hasIgnoreRules() {
+		current, _, err := r.client.IPAddresses.Get(data.Id.ValueString(), nil)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to read a CherryServers ip resource", err.Error())
+			return
+		}
+		currentTags = current.Tags
+	}
+
+	tagsMap, diags := r.tags.requestTags(ctx, data.Tags, currentTags)
 	resp.Diagnostics.Append(diags...)
 
 	request.Tags = &tagsMap
@@ -380,7 +451,7 @@ func (r *ipResource) Update(ctx context.Context, req resource.UpdateRequest, res
 		return
 	}
 
-	data.populateState(ip, ctx, resp.Diagnostics)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &data, ip)...)
 
 	ctx = tflog.SetField(ctx, "ip_id", data.Id)
 	tflog.Trace(ctx, "updated a resource")
@@ -399,6 +470,10 @@ func (r *ipResource) Delete(ctx context.Context, req resource.DeleteRequest, res
 		return
 	}
 
+	if deletionProtectionEnabled(data.DeletionProtection, resp, "IP") {
+		return
+	}
+
 	if _, err := r.client.IPAddresses.Unassign(data.Id.ValueString()); err != nil {
 		resp.Diagnostics.AddError(
 			"unable to unassign a CherryServers ip resource from target, before deleting",
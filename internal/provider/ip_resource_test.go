@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"github.com/cherryservers/cherrygo/v3"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -85,6 +86,138 @@ func TestAccIPResource_fullConfig(t *testing.T) {
 	})
 }
 
+func TestAccIPResource_defaultTags(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_ip.test_ip_ip"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPResourceDefaultTagsConfig(projectName, teamId, "dev"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.env", "test"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "dev"),
+				),
+			},
+			// Changing the default tags updates the resource.
+			{
+				Config: testAccIPResourceDefaultTagsConfig(projectName, teamId, "ops"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags_all.owner", "ops"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIPResourceDefaultTagsConfig(projectName, teamId, owner string) string {
+	return fmt.Sprintf(`
+provider "cherryservers" {
+  default_tags {
+    tags = {
+      env   = "dev"
+      owner = "%s"
+    }
+  }
+}
+
+resource "cherryservers_project" "test_ip_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_ip" "test_ip_ip" {
+  project_id = "${cherryservers_project.test_ip_project.id}"
+  region = "LT-Siauliai"
+  tags = {
+    env = "test"
+  }
+}
+`, owner, projectName, teamId)
+}
+
+func TestAccIPResource_ignoreTags(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_ip.test_ip_ip"
+	var ipID string
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIPResourceIgnoreTagsConfig(projectName, teamId, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersIPExists(resourceName),
+					func(s *terraform.State) error {
+						ipID = s.RootModule().Resources[resourceName].Primary.ID
+						return nil
+					},
+				),
+			},
+			// Tags written outside of Terraform don't show up as drift.
+			{
+				PreConfig: func() {
+					tags := map[string]string{"env": "test", "monitoring:agent": "1"}
+					if _, _, err := testCherryGoClient.IPAddresses.Update(ipID, &cherrygo.UpdateIPAddress{Tags: &tags}); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:   testAccIPResourceIgnoreTagsConfig(projectName, teamId, "test"),
+				PlanOnly: true,
+			},
+			// Tags written outside of Terraform are preserved on update.
+			{
+				Config: testAccIPResourceIgnoreTagsConfig(projectName, teamId, "prod"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.env", "prod"),
+					resource.TestCheckNoResourceAttr(resourceName, "tags_all.monitoring:agent"),
+					func(s *terraform.State) error {
+						ip, _, err := testCherryGoClient.IPAddresses.Get(ipID, nil)
+						if err != nil {
+							return err
+						}
+						if ip.Tags["monitoring:agent"] != "1" {
+							return fmt.Errorf("ignored tag was not preserved, got tags %v", ip.Tags)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccIPResourceIgnoreTagsConfig(projectName, teamId, env string) string {
+	return fmt.Sprintf(`
+provider "cherryservers" {
+  ignore_tags {
+    key_prefixes = ["monitoring:"]
+  }
+}
+
+resource "cherryservers_project" "test_ip_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_ip" "test_ip_ip" {
+  project_id = "${cherryservers_project.test_ip_project.id}"
+  region = "LT-Siauliai"
+  tags = {
+    env = "%s"
+  }
+}
+`, projectName, teamId, env)
+}
+
 func testAccIPResourceBasicConfig(projectName string, teamId string, region string) string {
 	return fmt.Sprintf(`
 resource "cherryservers_project" "test_ip_project" {
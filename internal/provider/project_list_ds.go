@@ -0,0 +1,182 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &projectListDS{}
+	_ datasource.DataSourceWithConfigure = &projectListDS{}
+)
+
+func NewProjectListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &projectListDS{configurator: configurator}
+	}
+}
+
+type projectListDS struct {
+	configurator
+}
+
+type projectListModel struct {
+	TeamId    types.Int64  `tfsdk:"team_id"`
+	NameRegex types.String `tfsdk:"name_regex"`
+	Projects  types.List   `tfsdk:"projects"`
+}
+
+type projectModel struct {
+	Id   types.Int64  `tfsdk:"id"`
+	Name types.String `tfsdk:"name"`
+	Href types.String `tfsdk:"href"`
+	BGP  types.Object `tfsdk:"bgp"`
+}
+
+func projectAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":   types.Int64Type,
+		"name": types.StringType,
+		"href": types.StringType,
+		"bgp":  types.ObjectType{AttrTypes: projectBGPModel{}.AttributeTypes()},
+	}
+}
+
+func (m *projectModel) populateState(ctx context.Context, project cherrygo.Project) diag.Diagnostics {
+	m.Id = types.Int64Value(int64(project.ID))
+	m.Name = types.StringValue(project.Name)
+	m.Href = types.StringValue(project.Href)
+
+	bgp := projectBGPModel{
+		Enabled:  types.BoolValue(project.Bgp.Enabled),
+		LocalASN: types.Int64Value(int64(project.Bgp.LocalASN)),
+	}
+	bgpObject, diags := types.ObjectValueFrom(ctx, bgp.AttributeTypes(), bgp)
+	m.BGP = bgpObject
+
+	return diags
+}
+
+func (d *projectListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_projects"
+}
+
+func (d *projectListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers projects data source. This can be used to list the projects of a team.",
+
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.Int64Attribute{
+				Description: "ID of the team, whose projects will be listed.",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Only list projects with names matching this regular expression.",
+				Optional:    true,
+			},
+			"projects": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: projectSchema(),
+				},
+				Computed:    true,
+				Description: "Projects matching the filters.",
+			},
+		},
+	}
+}
+
+func projectSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Description: "Project identifier.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the project.",
+			Computed:    true,
+		},
+		"href": schema.StringAttribute{
+			Description: "The API link to the project.",
+			Computed:    true,
+		},
+		"bgp": schema.SingleNestedAttribute{
+			Description: "Project border gateway protocol(BGP) configuration.",
+			Attributes: map[string]schema.Attribute{
+				"enabled": schema.BoolAttribute{
+					Computed:    true,
+					Description: "BGP is enabled for the project.",
+				},
+				"local_asn": schema.Int64Attribute{
+					Computed:    true,
+					Description: "The local ASN of the project.",
+				},
+			},
+			Computed: true,
+		},
+	}
+}
+
+func (d *projectListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state projectListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid name_regex", err.Error())
+			return
+		}
+	}
+
+	projects, _, err := d.Client().Projects.List(int(state.TeamId.ValueInt64()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("project list failed", err.Error())
+		return
+	}
+
+	projectModels := make([]projectModel, 0, len(projects))
+	for _, project := range projects {
+		if nameRegex != nil && !nameRegex.MatchString(project.Name) {
+			continue
+		}
+
+		var m projectModel
+		resp.Diagnostics.Append(m.populateState(ctx, project)...)
+		projectModels = append(projectModels, m)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectAttributeTypes()}, projectModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Projects = list
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccProjectListDS_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const dsName = "data.cherryservers_projects.test_projects"
+	const projectResourceName = "cherryservers_project.test_projects_project"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccProjectListDSConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "projects.#", "1"),
+					resource.TestCheckResourceAttrPair(dsName, "projects.0.id", projectResourceName, "id"),
+					resource.TestCheckResourceAttr(dsName, "projects.0.name", projectName),
+					resource.TestCheckResourceAttrSet(dsName, "projects.0.href"),
+					resource.TestCheckResourceAttrSet(dsName, "projects.0.bgp.enabled"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectListDSConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_projects_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+data "cherryservers_projects" "test_projects" {
+  team_id = "${cherryservers_project.test_projects_project.team_id}"
+  name_regex = "^${cherryservers_project.test_projects_project.name}$"
+}
+`, projectName, teamID)
+}
@@ -25,6 +25,7 @@ var (
 	_ resource.Resource                = &projectResource{}
 	_ resource.ResourceWithConfigure   = &projectResource{}
 	_ resource.ResourceWithImportState = &projectResource{}
+	_ resource.ResourceWithModifyPlan  = &projectResource{}
 )
 
 func NewProjectResource() resource.Resource {
@@ -33,7 +34,8 @@ func NewProjectResource() resource.Resource {
 
 // projectResource defines the resource implementation.
 type projectResource struct {
-	client *cherrygo.Client
+	client        *cherrygo.Client
+	defaultTeamID int64
 }
 
 // projectResourceModel describes the resource data model.
@@ -42,6 +44,8 @@ type projectResourceModel struct {
 	TeamId types.Int64  `tfsdk:"team_id"`
 	BGP    types.Object `tfsdk:"bgp"`
 	Id     types.String `tfsdk:"id"`
+
+	DeletionProtection types.Bool `tfsdk:"deletion_protection"`
 }
 
 func (d *projectResourceModel) populateState(project cherrygo.Project, ctx context.Context, diags diag.Diagnostics) {
@@ -76,12 +80,17 @@ func (r *projectResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Required:    true,
 			},
 			"team_id": schema.Int64Attribute{
-				Description: "The ID of the team that owns the project.",
-				Required:    true,
+				Description: "The ID of the team that owns the project. " +
+					"Defaults to the team-id of the provider config file profile.",
+				Optional: true,
+				Computed: true,
 				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
 					int64planmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
+			"deletion_protection": deletionProtectionAttribute("project"),
 			"bgp": schema.SingleNestedAttribute{
 				Description: "Project border gateway protocol (BGP) configuration.",
 				Attributes: map[string]schema.Attribute{
@@ -127,7 +136,36 @@ func (r *projectResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	r.client = DefaultClientConfigure(req, resp)
+	data := resourceDataConfigure(req, resp)
+	r.client = data.client
+	r.defaultTeamID = data.defaultTeamID
+}
+
+// ModifyPlan enforces deletion protection and sets team_id to the provider default team, if it is not configured.
+func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	checkDeletionProtection(ctx, req, resp, "project")
+
+	// Ignore destroy and update cases.
+	if req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	var teamID types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("team_id"), &teamID)...)
+	if resp.Diagnostics.HasError() || !teamID.IsNull() {
+		return
+	}
+
+	if r.defaultTeamID == 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("team_id"),
+			"Missing team_id",
+			"Set team_id, or select a provider config file profile with a team-id.",
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("team_id"), r.defaultTeamID)...)
 }
 
 func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -179,6 +217,11 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	// Deletion protection is not set on import.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	projectId, _ := strconv.Atoi(data.Id.ValueString())
 	project, projectGetResp, err := r.client.Projects.Get(projectId, nil)
 	if err != nil {
@@ -249,6 +292,10 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	if deletionProtectionEnabled(data.DeletionProtection, resp, "project") {
+		return
+	}
+
 	projectId, _ := strconv.Atoi(data.Id.ValueString())
 	if _, err := r.client.Projects.Delete(projectId); err != nil {
 		resp.Diagnostics.AddError(
@@ -49,6 +49,36 @@ func TestAccProjectResource_basic(t *testing.T) {
 	})
 }
 
+func TestAccProjectResource_deletionProtection(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	name := testProjectNamePrefix + acctest.RandString(5)
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersProjectDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccProjectResourceDeletionProtectionConfig(name, teamId, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersProjectExists("cherryservers_project.test"),
+					resource.TestCheckResourceAttr("cherryservers_project.test", "deletion_protection", "true"),
+				),
+			},
+			{
+				Config:      testAccProjectResourceDeletionProtectionConfig(name, teamId, true),
+				Destroy:     true,
+				ExpectError: regexp.MustCompile("Deletion protection enabled"),
+			},
+			{
+				Config: testAccProjectResourceDeletionProtectionConfig(name, teamId, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("cherryservers_project.test", "deletion_protection", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccProjectResourceConfig(name string, teamId string) string {
 	return fmt.Sprintf(`
 resource "cherryservers_project" "test" {
@@ -58,6 +88,16 @@ resource "cherryservers_project" "test" {
 `, name, teamId)
 }
 
+func testAccProjectResourceDeletionProtectionConfig(name string, teamId string, deletionProtection bool) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test" {
+  name                = "%s"
+  team_id             = "%s"
+  deletion_protection = %t
+}
+`, name, teamId, deletionProtection)
+}
+
 func testAccCheckCherryServersProjectExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		projectID, err := testAccGetResourceIdInt(resourceName, "project", s)
@@ -3,9 +3,15 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"strings"
+	"time"
 
 	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -14,7 +20,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"terraform-provider-cherryservers/internal/provider/datasourcebase"
 )
 
@@ -34,7 +42,30 @@ type CherryServersProvider struct {
 
 // CherryServersProviderModel describes the provider data model.
 type CherryServersProviderModel struct {
-	APIToken types.String `tfsdk:"api_token"`
+	APIToken             types.String  `tfsdk:"api_token"`
+	APIURL               types.String  `tfsdk:"api_url"`
+	RequestTimeout       types.Int64   `tfsdk:"request_timeout"`
+	InsecureSkipVerify   types.Bool    `tfsdk:"insecure_skip_verify"`
+	CABundle             types.String  `tfsdk:"ca_bundle"`
+	MaxRetries           types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin         types.Int64   `tfsdk:"retry_wait_min"`
+	RetryWaitMax         types.Int64   `tfsdk:"retry_wait_max"`
+	MaxRequestsPerSecond types.Float64 `tfsdk:"max_requests_per_second"`
+	ConfigFile           types.String  `tfsdk:"config_file"`
+	Profile              types.String  `tfsdk:"profile"`
+	DefaultTags          types.Object  `tfsdk:"default_tags"`
+	IgnoreTags           types.Object  `tfsdk:"ignore_tags"`
+}
+
+// providerDefaultTagsModel describes the default_tags block.
+type providerDefaultTagsModel struct {
+	Tags types.Map `tfsdk:"tags"`
+}
+
+// providerIgnoreTagsModel describes the ignore_tags block.
+type providerIgnoreTagsModel struct {
+	Keys        types.Set `tfsdk:"keys"`
+	KeyPrefixes types.Set `tfsdk:"key_prefixes"`
 }
 
 func (p *CherryServersProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +81,103 @@ func (p *CherryServersProvider) Schema(ctx context.Context, req provider.SchemaR
 				Optional:    true,
 				Sensitive:   true,
 			},
+			"api_url": schema.StringAttribute{
+				Description: "Base URL of the Cherry Servers API. Defaults to the public API. " +
+					"Can also be set with the CHERRY_API_URL environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"request_timeout": schema.Int64Attribute{
+				Description: "Timeout of a single API request attempt in seconds, applied to each retry separately. No timeout by default.",
+				Optional:    true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Description: "Skip TLS certificate verification of the API. Only intended for test environments.",
+				Optional:    true,
+			},
+			"ca_bundle": schema.StringAttribute{
+				Description: "PEM-encoded CA certificates to trust in addition to the system ones, " +
+					"for APIs served with a private certificate authority.",
+				Optional: true,
+			},
+			"max_retries": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum number of retries of an API request that failed with a transient error. "+
+					"Rate limited requests are always retried, server and network errors only for idempotent requests. "+
+					"Set to 0 to disable retries. Defaults to %d.", defaultMaxRetries),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				Description: fmt.Sprintf("Minimum time in seconds to wait before retrying an API request. "+
+					"The wait doubles with each retry. Defaults to %d.", int(defaultRetryWaitMin.Seconds())),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				Description: fmt.Sprintf("Maximum time in seconds to wait before retrying an API request, "+
+					"including waits requested by the API with the Retry-After header. Defaults to %d.", int(defaultRetryWaitMax.Seconds())),
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_requests_per_second": schema.Float64Attribute{
+				Description: "Maximum number of API requests per second, shared by all resources and data sources. " +
+					"Requests over the limit are delayed. Unlimited by default.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0.01),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				Description: "Path to a cherryctl config file, holding a token and optional api-url and team-id defaults. " +
+					"Takes precedence over profile. " +
+					"Can also be set with the CHERRY_CONFIG_FILE environment variable.",
+				Optional: true,
+			},
+			"profile": schema.StringAttribute{
+				Description: "Name of the cherryctl context to use, read from ~/.config/cherry/<profile>.yaml. " +
+					"The config file token takes precedence over the CHERRY_AUTH_KEY and CHERRY_AUTH_TOKEN environment variables, but not over api_token. " +
+					"Can also be set with the CHERRY_PROFILE environment variable.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"default_tags": schema.SingleNestedBlock{
+				Description: "Tags merged into the tags of every taggable resource. Resource tags override default tags with the same key.",
+				Attributes: map[string]schema.Attribute{
+					"tags": schema.MapAttribute{
+						Description: "Key/value metadata applied to all taggable resources.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"ignore_tags": schema.SingleNestedBlock{
+				Description: "Tags managed outside of Terraform. Matching tags are left out of the state of taggable resources " +
+					"and preserved when they are updated, unless they are also set on the resource.",
+				Attributes: map[string]schema.Attribute{
+					"keys": schema.SetAttribute{
+						Description: "Exact tag keys to ignore.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+					"key_prefixes": schema.SetAttribute{
+						Description: "Tag key prefixes to ignore.",
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
@@ -65,8 +193,6 @@ func (p *CherryServersProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 
-	// Configuration values are now available.
-	// if data.Endpoint.IsNull() { /* ... */ }
 	if data.APIToken.IsUnknown() {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("api_token"),
@@ -77,17 +203,90 @@ func (p *CherryServersProvider) Configure(ctx context.Context, req provider.Conf
 		)
 	}
 
+	if data.APIURL.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_url"),
+			"Unknown CherryServers API URL",
+			"The provider cannot create the CherryServers API client as there is an unknown configuration value for the CherryServers API URL. "+
+				"Either target apply the source of the value first, set the value statically in the configuration,"+
+				" or use the CHERRY_API_URL environment variable.",
+		)
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	apiToken := os.Getenv("CHERRY_AUTH_KEY")
-	if apiToken == "" {
-		apiToken = os.Getenv("CHERRY_AUTH_TOKEN")
+	configFilePath := os.Getenv("CHERRY_CONFIG_FILE")
+	if !data.ConfigFile.IsNull() {
+		configFilePath = data.ConfigFile.ValueString()
+	}
+
+	profileName := os.Getenv("CHERRY_PROFILE")
+	if !data.Profile.IsNull() {
+		profileName = data.Profile.ValueString()
 	}
 
-	if !data.APIToken.IsNull() {
-		apiToken = data.APIToken.ValueString()
+	// An explicit config file takes precedence over the cherryctl context of the profile.
+	var profile configFile
+	var profileSource string
+	switch {
+	case configFilePath != "":
+		var err error
+		if profile, err = loadConfigFile(configFilePath); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config_file"),
+				"Unable to Load CherryServers Config File",
+				"The provider cannot read the CherryServers API token from the config file: "+err.Error(),
+			)
+			return
+		}
+		profileSource = "config file " + configFilePath
+	case profileName != "":
+		dir, err := configDir()
+		if err == nil {
+			profile, configFilePath, err = loadProfile(dir, profileName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile"),
+				"Unable to Load CherryServers Config File Profile",
+				"The provider cannot read the CherryServers API token from the cherryctl config: "+err.Error(),
+			)
+			return
+		}
+		profileSource = fmt.Sprintf("profile %q (%s)", profileName, configFilePath)
+	}
+
+	// The API token is taken from the first source that supplies one, in order of precedence.
+	tokenSources := []struct {
+		token  string
+		source string
+	}{
+		{data.APIToken.ValueString(), "the api_token provider argument"},
+		{profile.Token, profileSource},
+		{os.Getenv("CHERRY_AUTH_KEY"), "the CHERRY_AUTH_KEY environment variable"},
+		{os.Getenv("CHERRY_AUTH_TOKEN"), "the CHERRY_AUTH_TOKEN environment variable"},
+	}
+
+	var apiToken, tokenSource string
+	var ignoredSources []string
+	for _, s := range tokenSources {
+		switch {
+		case s.token == "":
+		case apiToken == "":
+			apiToken, tokenSource = s.token, s.source
+		default:
+			ignoredSources = append(ignoredSources, s.source)
+		}
+	}
+
+	if len(ignoredSources) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Multiple CherryServers API Token Sources",
+			fmt.Sprintf("The CherryServers API token is taken from %s. The token set by %s is ignored.",
+				tokenSource, strings.Join(ignoredSources, " and ")),
+		)
 	}
 
 	if apiToken == "" {
@@ -95,15 +294,56 @@ func (p *CherryServersProvider) Configure(ctx context.Context, req provider.Conf
 			path.Root("api_token"),
 			"Missing CherryServers API Token",
 			"The provider cannot create the CherryServers API client as there is a missing or empty value for the CherryServers API token. "+
-				"Set the API token value in the configuration or use the CHERRY_AUTH_TOKEN or CHERRY_AUTH_KEY environment variables. "+
+				"Set the API token value in the configuration, select a config file profile or use the CHERRY_AUTH_TOKEN or CHERRY_AUTH_KEY environment variables. "+
 				"If either is already set, ensure the value is not empty.",
 		)
 	}
 
+	var tags tagsConfig
+	if !data.DefaultTags.IsNull() {
+		var defaultTagsData providerDefaultTagsModel
+		resp.Diagnostics.Append(data.DefaultTags.As(ctx, &defaultTagsData, basetypes.ObjectAsOptions{})...)
+		if !defaultTagsData.Tags.IsNull() {
+			resp.Diagnostics.Append(defaultTagsData.Tags.ElementsAs(ctx, &tags.defaultTags, false)...)
+		}
+	}
+
+	if !data.IgnoreTags.IsNull() {
+		var ignoreTagsData providerIgnoreTagsModel
+		resp.Diagnostics.Append(data.IgnoreTags.As(ctx, &ignoreTagsData, basetypes.ObjectAsOptions{})...)
+		if !ignoreTagsData.Keys.IsNull() {
+			resp.Diagnostics.Append(ignoreTagsData.Keys.ElementsAs(ctx, &tags.ignoreKeys, false)...)
+		}
+		if !ignoreTagsData.KeyPrefixes.IsNull() {
+			resp.Diagnostics.Append(ignoreTagsData.KeyPrefixes.ElementsAs(ctx, &tags.ignoreKeyPrefixes, false)...)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	tflog.Info(ctx, "Using CherryServers API token from "+tokenSource)
+
+	apiURL := profile.APIURL
+	if envURL := os.Getenv("CHERRY_API_URL"); envURL != "" {
+		apiURL = envURL
+	}
+	if !data.APIURL.IsNull() {
+		apiURL = data.APIURL.ValueString()
+	}
+
+	if apiURL != "" {
+		if u, err := url.Parse(apiURL); err != nil || u.Scheme == "" || u.Host == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("api_url"),
+				"Invalid CherryServers API URL",
+				fmt.Sprintf("The CherryServers API URL %q must be an absolute URL, such as https://api.cherryservers.com/v1/.", apiURL),
+			)
+			return
+		}
+	}
+
 	ctx = tflog.SetField(ctx, "cherryservers_api_token", apiToken)
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, "cherryservers_api_token")
 
@@ -112,6 +352,50 @@ func (p *CherryServersProvider) Configure(ctx context.Context, req provider.Conf
 	// Example client configuration for data sources and resources
 	userAgent := fmt.Sprintf("terraform-provider/cherryservers/%s terraform/%s", p.version, req.TerraformVersion)
 	args := []cherrygo.ClientOpt{cherrygo.WithAuthToken(apiToken), cherrygo.WithUserAgent(userAgent)}
+	if apiURL != "" {
+		tflog.Debug(ctx, "Using custom CherryServers API URL", map[string]interface{}{"api_url": apiURL})
+		args = append(args, cherrygo.WithURL(apiURL))
+	}
+
+	httpCfg := httpClientConfig{
+		timeout:            time.Duration(data.RequestTimeout.ValueInt64()) * time.Second,
+		insecureSkipVerify: data.InsecureSkipVerify.ValueBool(),
+		caBundle:           data.CABundle.ValueString(),
+		maxRetries:         defaultMaxRetries,
+		retryWaitMin:       defaultRetryWaitMin,
+		retryWaitMax:       defaultRetryWaitMax,
+		requestsPerSecond:  data.MaxRequestsPerSecond.ValueFloat64(),
+	}
+	if !data.MaxRetries.IsNull() {
+		httpCfg.maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+	if !data.RetryWaitMin.IsNull() {
+		httpCfg.retryWaitMin = time.Duration(data.RetryWaitMin.ValueInt64()) * time.Second
+	}
+	if !data.RetryWaitMax.IsNull() {
+		httpCfg.retryWaitMax = time.Duration(data.RetryWaitMax.ValueInt64()) * time.Second
+	}
+
+	if httpCfg.retryWaitMin > httpCfg.retryWaitMax {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_wait_min"),
+			"Invalid CherryServers Retry Wait",
+			fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s).", httpCfg.retryWaitMin, httpCfg.retryWaitMax),
+		)
+		return
+	}
+
+	httpClient, err := newHTTPClient(httpCfg)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("ca_bundle"),
+			"Invalid CherryServers CA Bundle",
+			"The provider cannot create the CherryServers API client: "+err.Error(),
+		)
+		return
+	}
+	args = append(args, cherrygo.WithHTTPClient(httpClient))
+
 	client, err := cherrygo.NewClient(args...)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -123,7 +407,11 @@ func (p *CherryServersProvider) Configure(ctx context.Context, req provider.Conf
 		return
 	}
 	resp.DataSourceData = client
-	resp.ResourceData = client
+	resp.ResourceData = &resourceData{
+		client:        client,
+		defaultTeamID: profile.TeamID,
+		tags:          tags,
+	}
 
 	tflog.Info(ctx, "Successfully created CherryServers client")
 }
@@ -134,6 +422,12 @@ func (p *CherryServersProvider) Resources(ctx context.Context) []func() resource
 		NewIpResource,
 		NewServerResource,
 		NewSSHKeyResource,
+		NewStorageResource,
+		NewStorageAttachmentResource,
+		NewBackupStorageResource,
+		NewServerPowerResource,
+		NewIPAssignmentResource,
+		NewIPDNSRecordsResource,
 	}
 }
 
@@ -149,6 +443,15 @@ func (p *CherryServersProvider) DataSources(ctx context.Context) []func() dataso
 		NewPlanSingleDS(cfg),
 		NewPlanListDS(cfg),
 		NewCycleListDS(cfg),
+		NewBackupStorageDS(cfg),
+		NewServerListDS(cfg),
+		NewIPListDS(cfg),
+		NewProjectListDS(cfg),
+		NewTeamSingleDS(cfg),
+		NewTeamListDS(cfg),
+		NewImageListDS(cfg),
+		NewUserDS(cfg),
+		NewBGPSessionListDS(cfg),
 	}
 }
 
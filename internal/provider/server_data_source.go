@@ -51,9 +51,9 @@ type serverDataSourceModel struct {
 	Timeouts        timeouts.Value `tfsdk:"timeouts"`
 }
 
-func (d *serverDataSourceModel) populateModel(server cherrygo.Server, ctx context.Context, diags diag.Diagnostics, powerState string) {
+func (d *serverDataSourceModel) populateModel(server cherrygo.Server, ctx context.Context, powerState string) diag.Diagnostics {
 	var resourceModel serverResourceModel
-	resourceModel.populateModel(server, ctx, diags, powerState)
+	diags := resourceModel.populateModel(server, ctx, powerState)
 
 	d.Plan = resourceModel.Plan
 	d.ProjectId = resourceModel.ProjectId
@@ -65,11 +65,14 @@ func (d *serverDataSourceModel) populateModel(server cherrygo.Server, ctx contex
 	d.Tags = resourceModel.Tags
 	d.SpotInstance = resourceModel.SpotInstance
 	d.OSPartitionSize = resourceModel.OSPartitionSize
-	d.PowerState = resourceModel.PowerState
+	// Unlike the resource argument, the data source reports the power state as returned by the API.
+	d.PowerState = types.StringValue(powerState)
 	d.State = resourceModel.State
 	d.IpAddresses = resourceModel.IpAddresses
 	d.Id = resourceModel.Id
 	d.Pricing = resourceModel.Pricing
+
+	return diags
 }
 
 func (d *serverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -256,7 +259,7 @@ func (d *serverDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		resp.Diagnostics.AddError("Unable to normalize CherryServers server image", err.Error())
 	}
 
-	data.populateModel(server, ctx, resp.Diagnostics, powerState.Power)
+	resp.Diagnostics.Append(data.populateModel(server, ctx, powerState.Power)...)
 
 	tflog.Trace(ctx, "read a data source")
 
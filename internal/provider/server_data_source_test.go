@@ -33,7 +33,7 @@ func TestAccServerDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair(dataSourceName, "tags", resourceName, "tags"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "spot_instance", resourceName, "spot_instance"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "os_partition_size", resourceName, "os_partition_size"),
-					resource.TestCheckResourceAttrPair(dataSourceName, "power_state", resourceName, "power_state"),
+					resource.TestCheckResourceAttr(dataSourceName, "power_state", "Powered on"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "state", resourceName, "state"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "ip_addresses", resourceName, "ip_addresses"),
 					resource.TestCheckResourceAttrPair(dataSourceName, "pricing.price", resourceName, "pricing.price"),
@@ -49,7 +49,7 @@ func TestAccServerDataSource_basic(t *testing.T) {
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "tags", resourceName, "tags"),
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "spot_instance", resourceName, "spot_instance"),
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "os_partition_size", resourceName, "os_partition_size"),
-					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "power_state", resourceName, "power_state"),
+					resource.TestCheckResourceAttr("data.cherryservers_server.test_server_server_by_hostname", "power_state", "Powered on"),
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "state", resourceName, "state"),
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "ip_addresses", resourceName, "ip_addresses"),
 					resource.TestCheckResourceAttrPair("data.cherryservers_server.test_server_server_by_hostname", "pricing.price", resourceName, "pricing.price"),
@@ -0,0 +1,346 @@
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &serverListDS{}
+	_ datasource.DataSourceWithConfigure = &serverListDS{}
+)
+
+func NewServerListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &serverListDS{configurator: configurator}
+	}
+}
+
+type serverListDS struct {
+	configurator
+}
+
+type serverListModel struct {
+	ProjectId     types.Int64  `tfsdk:"project_id"`
+	Region        types.String `tfsdk:"region"`
+	Plan          types.String `tfsdk:"plan"`
+	State         types.String `tfsdk:"state"`
+	HostnameRegex types.String `tfsdk:"hostname_regex"`
+	Tags          types.Map    `tfsdk:"tags"`
+	Servers       types.List   `tfsdk:"servers"`
+}
+
+type serverListElementModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	Hostname     types.String `tfsdk:"hostname"`
+	Plan         types.String `tfsdk:"plan"`
+	Region       types.String `tfsdk:"region"`
+	ProjectId    types.Int64  `tfsdk:"project_id"`
+	Image        types.String `tfsdk:"image"`
+	SSHKeyIds    types.Set    `tfsdk:"ssh_key_ids"`
+	Tags         types.Map    `tfsdk:"tags"`
+	SpotInstance types.Bool   `tfsdk:"spot_instance"`
+	PowerState   types.String `tfsdk:"power_state"`
+	State        types.String `tfsdk:"state"`
+	IpAddresses  types.Set    `tfsdk:"ip_addresses"`
+	Pricing      types.Object `tfsdk:"pricing"`
+}
+
+func serverListElementAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":            types.StringType,
+		"name":          types.StringType,
+		"hostname":      types.StringType,
+		"plan":          types.StringType,
+		"region":        types.StringType,
+		"project_id":    types.Int64Type,
+		"image":         types.StringType,
+		"ssh_key_ids":   types.SetType{ElemType: types.StringType},
+		"tags":          types.MapType{ElemType: types.StringType},
+		"spot_instance": types.BoolType,
+		"power_state":   types.StringType,
+		"state":         types.StringType,
+		"ip_addresses":  types.SetType{ElemType: types.ObjectType{AttrTypes: ipAddressFlatResourceModel{}.AttributeTypes()}},
+		"pricing":       types.ObjectType{AttrTypes: serverPricingModel{}.AttributeTypes()},
+	}
+}
+
+func (m *serverListElementModel) populateState(ctx context.Context, server cherrygo.Server, powerState string) diag.Diagnostics {
+	var resourceModel serverResourceModel
+	diags := resourceModel.populateModel(server, ctx, powerState)
+
+	m.Id = resourceModel.Id
+	m.Name = resourceModel.Name
+	m.Hostname = resourceModel.Hostname
+	m.Plan = resourceModel.Plan
+	m.Region = resourceModel.Region
+	m.ProjectId = resourceModel.ProjectId
+	m.Image = resourceModel.Image
+	m.SSHKeyIds = resourceModel.SSHKeyIds
+	m.Tags = resourceModel.Tags
+	m.SpotInstance = resourceModel.SpotInstance
+	// Reported as returned by the API, like in the cherryservers_server data source.
+	m.PowerState = types.StringValue(powerState)
+	m.State = resourceModel.State
+	m.IpAddresses = resourceModel.IpAddresses
+	m.Pricing = resourceModel.Pricing
+
+	return diags
+}
+
+// matches reports whether the server satisfies all the configured filters.
+func (m *serverListModel) matches(ctx context.Context, server cherrygo.Server, hostnameRegex *regexp.Regexp) (bool, diag.Diagnostics) {
+	if !m.Region.IsNull() && m.Region.ValueString() != server.Region.Slug {
+		return false, nil
+	}
+	if !m.Plan.IsNull() && m.Plan.ValueString() != server.Plan.Slug {
+		return false, nil
+	}
+	if !m.State.IsNull() && m.State.ValueString() != server.State {
+		return false, nil
+	}
+	if hostnameRegex != nil && !hostnameRegex.MatchString(server.Hostname) {
+		return false, nil
+	}
+
+	if !m.Tags.IsNull() {
+		tags := make(map[string]string, len(m.Tags.Elements()))
+		if diags := m.Tags.ElementsAs(ctx, &tags, false); diags.HasError() {
+			return false, diags
+		}
+
+		for k, v := range tags {
+			if server.Tags[k] != v {
+				return false, nil
+			}
+		}
+	}
+
+	return true, nil
+}
+
+func (d *serverListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_servers"
+}
+
+func (d *serverListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers servers data source. This can be used to list and filter servers in a project. " +
+			"Reading the power state takes an extra API request for every listed server, so filter large projects.",
+
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, whose servers will be listed.",
+				Required:    true,
+			},
+			"region": schema.StringAttribute{
+				Description: "Only list servers in the region with this slug.",
+				Optional:    true,
+			},
+			"plan": schema.StringAttribute{
+				Description: "Only list servers with this plan slug.",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Only list servers in this state, such as 'pending' or 'active'.",
+				Optional:    true,
+			},
+			"hostname_regex": schema.StringAttribute{
+				Description: "Only list servers with hostnames matching this regular expression.",
+				Optional:    true,
+			},
+			"tags": schema.MapAttribute{
+				Description: "Only list servers that have all of these key/value tags.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"servers": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: serverListElementSchema(),
+				},
+				Computed:    true,
+				Description: "Servers matching the filters.",
+			},
+		},
+	}
+}
+
+func serverListElementSchema() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "Server identifier.",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "Name of the server.",
+			Computed:    true,
+		},
+		"hostname": schema.StringAttribute{
+			Description: "Hostname of the server.",
+			Computed:    true,
+		},
+		"plan": schema.StringAttribute{
+			Description: "Slug of the plan. Example: e5_1620v4. [See List Plans](https://api.cherryservers.com/doc/#tag/Plans/operation/get-plans).",
+			Computed:    true,
+		},
+		"region": schema.StringAttribute{
+			Description: "Slug of the region. Example: LT-Siauliai [See List Regions](https://api.cherryservers.com/doc/#tag/Regions/operation/get-regions).",
+			Computed:    true,
+		},
+		"project_id": schema.Int64Attribute{
+			Description: "CherryServers project id, associated with the server.",
+			Computed:    true,
+		},
+		"image": schema.StringAttribute{
+			Description: "Slug of the operating system. Example: ubuntu_22_04. [See List Images](https://api.cherryservers.com/doc/#tag/Images/operation/get-plan-images).",
+			Computed:    true,
+		},
+		"ssh_key_ids": schema.SetAttribute{
+			Description: "Set of the SSH key IDs allowed to SSH to the server.",
+			Computed:    true,
+			ElementType: types.StringType,
+		},
+		"tags": schema.MapAttribute{
+			Description: "Key/value metadata for server tagging.",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+		"spot_instance": schema.BoolAttribute{
+			Description: "If True, provisions the server as a spot instance.",
+			Computed:    true,
+		},
+		"power_state": schema.StringAttribute{
+			Description: "The power state of the server, such as 'Powered off' or 'Powered on'. " +
+				"The server list does not include it, so it costs an extra API request for every listed server.",
+			Computed: true,
+		},
+		"state": schema.StringAttribute{
+			Description: "The state of the server, such as 'pending' or 'active'.",
+			Computed:    true,
+		},
+		"ip_addresses": schema.SetNestedAttribute{
+			Description: "IP addresses attached to the server.",
+			Computed:    true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Description: "ID of the IP address.",
+						Computed:    true,
+					},
+					"type": schema.StringAttribute{
+						Description: "Type of the IP address.",
+						Computed:    true,
+					},
+					"address": schema.StringAttribute{
+						Description: "Address of the IP address.",
+						Computed:    true,
+					},
+					"address_family": schema.Int64Attribute{
+						Description: "Address family of the IP address.",
+						Computed:    true,
+					},
+					"cidr": schema.StringAttribute{
+						Description: "CIDR of the IP address.",
+						Computed:    true,
+					},
+				},
+			},
+		},
+		"pricing": schema.SingleNestedAttribute{
+			Description: "Server pricing data.",
+			Computed:    true,
+			Attributes: map[string]schema.Attribute{
+				"price": schema.Float32Attribute{
+					Computed:    true,
+					Description: "Price for the server.",
+				},
+				"currency": schema.StringAttribute{
+					Computed:    true,
+					Description: "Pricing currency.",
+				},
+			},
+		},
+	}
+}
+
+func (d *serverListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state serverListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var hostnameRegex *regexp.Regexp
+	if !state.HostnameRegex.IsNull() {
+		var err error
+		hostnameRegex, err = regexp.Compile(state.HostnameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("invalid hostname_regex", err.Error())
+			return
+		}
+	}
+
+	servers, _, err := d.Client().Servers.List(int(state.ProjectId.ValueInt64()), nil)
+	if err != nil {
+		resp.Diagnostics.AddError("server list failed", err.Error())
+		return
+	}
+
+	planImages := newPlanImagesCache(d.Client())
+	serverModels := make([]serverListElementModel, 0, len(servers))
+	for _, server := range servers {
+		ok, diags := state.matches(ctx, server, hostnameRegex)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if !ok {
+			continue
+		}
+
+		powerState, _, err := d.Client().Servers.PowerState(server.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("unable to get CherryServers server power-state", err.Error())
+			return
+		}
+
+		if err = planImages.normalizeServerImage(&server); err != nil {
+			resp.Diagnostics.AddError("Unable to normalize CherryServers server image", err.Error())
+		}
+
+		var serverModel serverListElementModel
+		resp.Diagnostics.Append(serverModel.populateState(ctx, server, powerState.Power)...)
+		serverModels = append(serverModels, serverModel)
+	}
+
+	list, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: serverListElementAttributeTypes()}, serverModels)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Servers = list
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerListDS_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const dsName = "data.cherryservers_servers.test_servers"
+	const serverName = "cherryservers_server.test_servers_server"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccServerListDSConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "servers.#", "1"),
+					resource.TestCheckResourceAttrPair(dsName, "servers.0.id", serverName, "id"),
+					resource.TestCheckResourceAttrPair(dsName, "servers.0.hostname", serverName, "hostname"),
+					resource.TestCheckResourceAttr(dsName, "servers.0.region", "LT-Siauliai"),
+					resource.TestCheckResourceAttr(dsName, "servers.0.tags.env", "test"),
+					resource.TestCheckResourceAttr(dsName, "servers.0.power_state", "Powered on"),
+					resource.TestCheckResourceAttr("data.cherryservers_servers.test_servers_none", "servers.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerListDSConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_servers_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_servers_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_servers_project.id}"
+  hostname = "servers-ds-test"
+  tags = {
+    env = "test"
+  }
+}
+
+data "cherryservers_servers" "test_servers" {
+  project_id = "${cherryservers_server.test_servers_server.project_id}"
+  region = "LT-Siauliai"
+  hostname_regex = "^servers-ds-"
+  tags = {
+    env = "test"
+  }
+}
+
+data "cherryservers_servers" "test_servers_none" {
+  project_id = "${cherryservers_server.test_servers_server.project_id}"
+  tags = {
+    env = "prod"
+  }
+}
+`, projectName, teamID)
+}
@@ -0,0 +1,288 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	serverPowerActionReboot           = "reboot"
+	serverPowerActionPowerOn          = "power_on"
+	serverPowerActionPowerOff         = "power_off"
+	serverPowerActionPowerCycle       = "power_cycle"
+	serverPowerActionResetBMCPassword = "reset_bmc_password"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource              = &serverPowerResource{}
+	_ resource.ResourceWithConfigure = &serverPowerResource{}
+)
+
+func NewServerPowerResource() resource.Resource {
+	return &serverPowerResource{}
+}
+
+// serverPowerResource defines the resource implementation.
+// It performs a one-shot power action on creation, which is repeated whenever the triggers change.
+type serverPowerResource struct {
+	client *cherrygo.Client
+}
+
+// serverPowerResourceModel describes the resource data model.
+type serverPowerResourceModel struct {
+	Id       types.String   `tfsdk:"id"`
+	ServerId types.String   `tfsdk:"server_id"`
+	Action   types.String   `tfsdk:"action"`
+	Triggers types.Map      `tfsdk:"triggers"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *serverPowerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_server_power"
+}
+
+func (r *serverPowerResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers server power action resource. This can be used to reboot, power cycle or reset the BMC password of a server. " +
+			"The action is performed on creation and repeated whenever `triggers` change. Destroying the resource does not affect the server.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Server power action identifier. Equal to the server ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "ID of the server to perform the action on.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"action": schema.StringAttribute{
+				Description: "Action to perform. One of 'reboot', 'power_on', 'power_off', 'power_cycle' or 'reset_bmc_password'. " +
+					"'power_on' does nothing if the server is already powered on.",
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						serverPowerActionReboot,
+						serverPowerActionPowerOn,
+						serverPowerActionPowerOff,
+						serverPowerActionPowerCycle,
+						serverPowerActionResetBMCPassword,
+					),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				Description: "Arbitrary map of values that, when changed, will run the action again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *serverPowerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *serverPowerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data serverPowerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := strconv.Atoi(data.ServerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid server ID", err.Error())
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The action and the following waits share a single deadline.
+	waitCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err = r.runAction(waitCtx, serverID, data.Action.ValueString(), createTimeout); err != nil {
+		addServerWaitError(&resp.Diagnostics, fmt.Sprintf("unable to %s CherryServers server", data.Action.ValueString()), serverID, err)
+		return
+	}
+
+	if err = waitForServerActive(waitCtx, r.client, serverID, remainingTimeout(waitCtx, createTimeout)); err != nil {
+		addServerWaitError(&resp.Diagnostics, "CherryServers server did not become active", serverID, err)
+		return
+	}
+
+	data.Id = types.StringValue(strconv.Itoa(serverID))
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "server_id", data.ServerId)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *serverPowerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data serverPowerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := strconv.Atoi(data.ServerId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid server ID in state", err.Error())
+		return
+	}
+
+	server, serverGetResp, err := r.client.Servers.Get(serverID, &cherrygo.GetOptions{Fields: []string{"state"}})
+	if err != nil {
+		if is404Error(serverGetResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers server resource",
+			err.Error(),
+		)
+		return
+	}
+
+	if server.State == "terminating" {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles the timeouts, every other attribute requires replacement.
+func (r *serverPowerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data serverPowerResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state, the server is left untouched.
+func (r *serverPowerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data serverPowerResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "server_id", data.ServerId)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// runAction runs the power action and waits for its result. The waits share the ctx deadline,
+// each one gets the time remaining of timeout.
+func (r *serverPowerResource) runAction(ctx context.Context, serverID int, action string, timeout time.Duration) error {
+	tflog.Debug(ctx, "running server power action", map[string]interface{}{
+		"server_id": serverID,
+		"action":    action,
+	})
+
+	var err error
+	switch action {
+	case serverPowerActionReboot:
+		if _, _, err = r.client.Servers.Reboot(serverID); err != nil {
+			return err
+		}
+		return waitForServerReboot(ctx, r.client, serverID, remainingTimeout(ctx, timeout))
+	case serverPowerActionPowerOn:
+		// Waiting for a server that is already powered on verifies nothing.
+		power, _, err := r.client.Servers.PowerState(serverID)
+		if err != nil {
+			return err
+		}
+		if normalizePowerState(power.Power) == powerStateOn {
+			tflog.Debug(ctx, "server is already powered on", map[string]interface{}{
+				"server_id": serverID,
+			})
+			return nil
+		}
+		if _, _, err = r.client.Servers.PowerOn(serverID); err != nil {
+			return err
+		}
+		return waitForServerPowerState(ctx, r.client, serverID, powerStateOn, remainingTimeout(ctx, timeout))
+	case serverPowerActionPowerOff:
+		if _, _, err = r.client.Servers.PowerOff(serverID); err != nil {
+			return err
+		}
+		return waitForServerPowerState(ctx, r.client, serverID, powerStateOff, remainingTimeout(ctx, timeout))
+	case serverPowerActionPowerCycle:
+		if _, _, err = r.client.Servers.PowerOff(serverID); err != nil {
+			return err
+		}
+		if err = waitForServerPowerState(ctx, r.client, serverID, powerStateOff, remainingTimeout(ctx, timeout)); err != nil {
+			return err
+		}
+		if _, _, err = r.client.Servers.PowerOn(serverID); err != nil {
+			return err
+		}
+		return waitForServerPowerState(ctx, r.client, serverID, powerStateOn, remainingTimeout(ctx, timeout))
+	case serverPowerActionResetBMCPassword:
+		_, _, err = r.client.Servers.ResetBMCPassword(serverID)
+		return err
+	}
+
+	return fmt.Errorf("unknown server power action %q", action)
+}
@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccServerPowerResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_server_power.test_power"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersServerDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccServerPowerResourceConfig(projectName, teamId, "reboot", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "id", "cherryservers_server.test_power_server", "id"),
+					resource.TestCheckResourceAttr(resourceName, "action", "reboot"),
+					resource.TestCheckResourceAttr("cherryservers_server.test_power_server", "power_state", "on"),
+				),
+			},
+			// Trigger change testing
+			{
+				Config: testAccServerPowerResourceConfig(projectName, teamId, "power_cycle", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "action", "power_cycle"),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServerPowerResourceConfig(projectName string, teamID string, action string, trigger string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_power_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_power_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_power_project.id}"
+}
+
+resource "cherryservers_server_power" "test_power" {
+  server_id = "${cherryservers_server.test_power_server.id}"
+  action = "%s"
+  triggers = {
+    run = "%s"
+  }
+}
+`, projectName, teamID, action, trigger)
+}
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/cherryservers/cherrygo/v3"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,21 +20,30 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	powerStateOn  = "on"
+	powerStateOff = "off"
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &serverResource{}
 	_ resource.ResourceWithConfigure   = &serverResource{}
 	_ resource.ResourceWithImportState = &serverResource{}
+	_ resource.ResourceWithModifyPlan  = &serverResource{}
 )
 
 func NewServerResource() resource.Resource {
@@ -42,6 +53,7 @@ func NewServerResource() resource.Resource {
 // serverResource defines the resource implementation.
 type serverResource struct {
 	client *cherrygo.Client
+	tags   tagsConfig
 }
 
 // serverResourceModel describes the resource data model.
@@ -57,9 +69,11 @@ type serverResourceModel struct {
 	IPAddressesIds      types.Set      `tfsdk:"ip_addresses_ids"`
 	UserData            types.String   `tfsdk:"user_data"`
 	Tags                types.Map      `tfsdk:"tags"`
+	TagsAll             types.Map      `tfsdk:"tags_all"`
 	SpotInstance        types.Bool     `tfsdk:"spot_instance"`
 	OSPartitionSize     types.Int64    `tfsdk:"os_partition_size"`
 	PowerState          types.String   `tfsdk:"power_state"`
+	RebootTrigger       types.String   `tfsdk:"reboot_trigger"`
 	State               types.String   `tfsdk:"state"`
 	IpAddresses         types.Set      `tfsdk:"ip_addresses"`
 	Id                  types.String   `tfsdk:"id"`
@@ -68,6 +82,8 @@ type serverResourceModel struct {
 	Cycle               types.String   `tfsdk:"cycle"`
 	DiscountCode        types.String   `tfsdk:"discount_code"`
 	Pricing             types.Object   `tfsdk:"pricing"`
+	DeletionProtection  types.Bool     `tfsdk:"deletion_protection"`
+	BGP                 types.Object   `tfsdk:"bgp"`
 }
 
 type serverPricingModel struct {
@@ -82,7 +98,42 @@ func (m serverPricingModel) AttributeTypes() map[string]attr.Type {
 	}
 }
 
-func (d *serverResourceModel) populateModel(server cherrygo.Server, ctx context.Context, diags diag.Diagnostics, powerState string) {
+type serverBGPModel struct {
+	Enabled            types.Bool   `tfsdk:"enabled"`
+	Available          types.Bool   `tfsdk:"available"`
+	Status             types.String `tfsdk:"status"`
+	LocalASN           types.Int64  `tfsdk:"local_asn"`
+	Routers            types.List   `tfsdk:"routers"`
+	AdvertisedPrefixes types.List   `tfsdk:"advertised_prefixes"`
+}
+
+func (m serverBGPModel) AttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"enabled":             types.BoolType,
+		"available":           types.BoolType,
+		"status":              types.StringType,
+		"local_asn":           types.Int64Type,
+		"routers":             types.ListType{ElemType: types.StringType},
+		"advertised_prefixes": types.ListType{ElemType: types.StringType},
+	}
+}
+
+// bgpEnabled returns the server BGP state, it is unknown or null if the block is.
+func (d *serverResourceModel) bgpEnabled(ctx context.Context) (types.Bool, diag.Diagnostics) {
+	if d.BGP.IsNull() {
+		return types.BoolNull(), nil
+	}
+	if d.BGP.IsUnknown() {
+		return types.BoolUnknown(), nil
+	}
+
+	var bgp serverBGPModel
+	diags := d.BGP.As(ctx, &bgp, basetypes.ObjectAsOptions{})
+	return bgp.Enabled, diags
+}
+
+func (d *serverResourceModel) populateModel(server cherrygo.Server, ctx context.Context, powerState string) diag.Diagnostics {
+	var diags diag.Diagnostics
 	d.Plan = types.StringValue(server.Plan.Slug)
 	d.ProjectId = types.Int64Value(int64(server.Project.ID))
 	d.Region = types.StringValue(server.Region.Slug)
@@ -123,10 +174,11 @@ func (d *serverResourceModel) populateModel(server cherrygo.Server, ctx context.
 
 	tags, tagsDiags := types.MapValueFrom(ctx, types.StringType, server.Tags)
 	d.Tags = tags
+	d.TagsAll = tags
 	diags.Append(tagsDiags...)
 
 	d.SpotInstance = types.BoolValue(server.SpotInstance)
-	d.PowerState = types.StringValue(powerState)
+	d.PowerState = types.StringValue(normalizePowerState(powerState))
 	d.State = types.StringValue(server.State)
 	d.Id = types.StringValue(strconv.Itoa(server.ID))
 
@@ -138,7 +190,35 @@ func (d *serverResourceModel) populateModel(server cherrygo.Server, ctx context.
 	pricingTf, pricingDiags := types.ObjectValueFrom(ctx, pricing.AttributeTypes(), pricing)
 
 	d.Pricing = pricingTf
+
+	// Subnets routed to the server are the prefixes it can announce over BGP.
+	prefixes := make([]string, 0)
+	for _, ip := range server.IPAddresses {
+		if ip.Type == "subnet" {
+			prefixes = append(prefixes, ip.Cidr)
+		}
+	}
+
+	routers, routersDiags := types.ListValueFrom(ctx, types.StringType, server.Region.BGP.Hosts)
+	diags.Append(routersDiags...)
+	advertisedPrefixes, prefixesDiags := types.ListValueFrom(ctx, types.StringType, prefixes)
+	diags.Append(prefixesDiags...)
+
+	bgp := serverBGPModel{
+		Enabled:            types.BoolValue(server.BGP.Enabled),
+		Available:          types.BoolValue(server.BGP.Available),
+		Status:             types.StringValue(server.BGP.Status),
+		LocalASN:           types.Int64Value(int64(server.Project.Bgp.LocalASN)),
+		Routers:            routers,
+		AdvertisedPrefixes: advertisedPrefixes,
+	}
+
+	bgpTf, bgpDiags := types.ObjectValueFrom(ctx, bgp.AttributeTypes(), bgp)
+	d.BGP = bgpTf
+	diags.Append(bgpDiags...)
 	diags.Append(pricingDiags...)
+
+	return diags
 }
 
 type ipAddressFlatResourceModel struct {
@@ -173,6 +253,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 				Description: "Slug of the plan. Example: e5_1620v4. [See List Plans](https://api.cherryservers.com/doc/#tag/Plans/operation/get-plans).",
 			},
@@ -181,6 +262,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:    true,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"region": schema.StringAttribute{
@@ -188,6 +270,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Required:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -237,6 +320,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 				Validators: []validator.Set{
 					setvalidator.ConflictsWith(path.Expressions{
@@ -250,6 +334,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				ElementType: types.StringType,
 				PlanModifiers: []planmodifier.Set{
 					setplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 				DeprecationMessage: "use extra_ip_addresses_ids instead",
 			},
@@ -273,6 +358,11 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					mapplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"tags_all": schema.MapAttribute{
+				Description: "All tags of the server, including provider default tags.",
+				ElementType: types.StringType,
+				Computed:    true,
+			},
 			"spot_instance": schema.BoolAttribute{
 				Description: "If True, provisions the server as a spot instance.",
 				Optional:    true,
@@ -280,6 +370,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Default:     booldefault.StaticBool(false),
 				PlanModifiers: []planmodifier.Bool{
 					boolplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
@@ -294,12 +385,23 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"power_state": schema.StringAttribute{
-				Description: "The power state of the server, such as 'Powered off' or 'Powered on'.",
-				Computed:    true,
+				Description: "The power state of the server, 'on' or 'off'. " +
+					"Updating this attribute powers the server on or off. " +
+					"Earlier provider versions reported the API values 'Powered on' and 'Powered off' instead.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(powerStateOn, powerStateOff),
+				},
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"reboot_trigger": schema.StringAttribute{
+				Description: "Arbitrary value that reboots the server when changed. " +
+					"The server is not rebooted on creation.",
+				Optional: true,
+			},
 			"state": schema.StringAttribute{
 				Description: "The state of the server, such as 'pending' or 'active'.",
 				Computed:    true,
@@ -365,6 +467,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Server billing cycle slug. Default is 'hourly.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"discount_code": schema.StringAttribute{
@@ -372,6 +475,7 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Description: "Server discount code.",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplace(),
+					DenyReplaceIfDeletionProtected(),
 				},
 			},
 			"pricing": schema.SingleNestedAttribute{
@@ -388,6 +492,62 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					},
 				},
 			},
+			"bgp": schema.SingleNestedAttribute{
+				Description: "Server border gateway protocol (BGP) configuration. " +
+					"BGP must be enabled for the server project.",
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Optional:    true,
+						Computed:    true,
+						Description: "BGP is enabled for the server. Left unchanged if not set, new servers have BGP disabled.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"available": schema.BoolAttribute{
+						Computed:    true,
+						Description: "BGP is available for the server.",
+						PlanModifiers: []planmodifier.Bool{
+							boolplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"status": schema.StringAttribute{
+						Computed:    true,
+						Description: "Status of the server BGP session.",
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"local_asn": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The local ASN of the server project.",
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"routers": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "Addresses of the region BGP routers to peer with.",
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+					"advertised_prefixes": schema.ListAttribute{
+						Computed:    true,
+						ElementType: types.StringType,
+						Description: "CIDRs of the subnets routed to the server, which can be advertised over BGP.",
+						PlanModifiers: []planmodifier.List{
+							listplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+				Computed: true,
+				Optional: true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+			},
 			"allow_reinstall": schema.BoolAttribute{
 				Optional: true,
 				Computed: true,
@@ -396,9 +556,11 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"Server private IP may change on re-install.",
 				Default: booldefault.StaticBool(false),
 			},
+			"deletion_protection": deletionProtectionAttribute("server"),
 			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
 				Create: true,
 				Update: true,
+				Delete: true,
 			}),
 		},
 	}
@@ -407,8 +569,10 @@ func (r *serverResource) Schema(ctx context.Context, req resource.SchemaRequest,
 func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	var plan, state serverResourceModel
 
-	// We only care about updates for now, so return if state or plan are null.
-	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+	checkDeletionProtection(ctx, req, resp, "server")
+
+	// Ignore destroy cases.
+	if req.Plan.Raw.IsNull() {
 		return
 	}
 
@@ -417,6 +581,20 @@ func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
+	// Show the provider default tags merged into tags_all.
+	var diags diag.Diagnostics
+	plan.TagsAll, diags = r.tags.planTagsAll(ctx, plan.Tags)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The rest only applies to updates.
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+		return
+	}
+
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -458,7 +636,33 @@ func (r *serverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		plan.IpAddresses = ipsTf
 	}
 
-	diags := resp.Plan.Set(ctx, plan)
+	// BGP session data changes when BGP is toggled.
+	planBGP, diags := plan.bgpEnabled(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateBGP, diags := state.bgpEnabled(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !planBGP.IsUnknown() && !planBGP.Equal(stateBGP) {
+		bgpTf, bgpDiags := types.ObjectValue(serverBGPModel{}.AttributeTypes(), map[string]attr.Value{
+			"enabled":             planBGP,
+			"available":           types.BoolUnknown(),
+			"status":              types.StringUnknown(),
+			"local_asn":           types.Int64Unknown(),
+			"routers":             types.ListUnknown(types.StringType),
+			"advertised_prefixes": types.ListUnknown(types.StringType),
+		})
+		resp.Diagnostics.Append(bgpDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		plan.BGP = bgpTf
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 }
 
@@ -468,7 +672,18 @@ func (r *serverResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = DefaultClientConfigure(req, resp)
+	data := resourceDataConfigure(req, resp)
+	r.client = data.client
+	r.tags = data.tags
+}
+
+// populateWithTags populates the model from the API, keeping the provider default tags out of tags.
+func (r *serverResource) populateWithTags(ctx context.Context, data *serverResourceModel, server cherrygo.Server, powerState string) diag.Diagnostics {
+	tags, tagsAll, diags := r.tags.stateTags(ctx, server.Tags, data.Tags)
+	diags.Append(data.populateModel(server, ctx, powerState)...)
+	data.Tags, data.TagsAll = tags, tagsAll
+
+	return diags
 }
 
 func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -515,9 +730,8 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		request.IPAddresses = ipsIds
 	}
 
-	if !data.Tags.IsNull() && !data.Tags.IsUnknown() {
-		tagsMap := make(map[string]string, len(data.Tags.Elements()))
-		diags := data.Tags.ElementsAs(ctx, &tagsMap, false)
+	if !data.Tags.IsUnknown() {
+		tagsMap, diags := r.tags.requestTags(ctx, data.Tags, nil)
 		resp.Diagnostics.Append(diags...)
 
 		request.Tags = &tagsMap
@@ -546,46 +760,44 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	// Record the server ID right away, so that a failed or interrupted deployment
+	// leaves a tainted resource in the state instead of an orphaned server.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), strconv.Itoa(server.ID))...)
+
 	createTimeout, diags := data.Timeouts.Create(ctx, 60*time.Minute)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	err = backoff.Retry(
-		func() error {
-			stateOption := cherrygo.GetOptions{Fields: []string{"state"}}
-			s, _, e := r.client.Servers.Get(server.ID, &stateOption)
-			if e != nil {
-				return backoff.Permanent(e)
-			}
-
-			if s.State == "pending" || s.State == "provisioning" {
-				return errors.New("server is in inactive state")
-			}
-
-			if s.State == "active" {
-				return nil
-			}
-
-			return backoff.Permanent(errors.New("failed to deploy server"))
-		}, backoff.NewExponentialBackOff(
-			backoff.WithMaxElapsedTime(createTimeout),
-			backoff.WithInitialInterval(time.Second*10)))
-	if err != nil {
-		resp.Diagnostics.AddError("unable to deploy CherryServers server", err.Error())
+	if err = waitForServerActive(ctx, r.client, server.ID, createTimeout); err != nil {
+		addServerWaitError(&resp.Diagnostics, "unable to deploy CherryServers server", server.ID, err)
 		return
 	}
 
+	if data.PowerState.ValueString() == powerStateOff {
+		if err = r.setPowerState(ctx, server.ID, powerStateOff, createTimeout); err != nil {
+			addServerWaitError(&resp.Diagnostics, "unable to power off CherryServers server", server.ID, err)
+			return
+		}
+	}
+
 	powerState, _, err := r.client.Servers.PowerState(server.ID)
 	if err != nil {
 		resp.Diagnostics.AddError("unable to get CherryServers server power-state", err.Error())
 		return
 	}
 
+	bgpEnabled, diags := data.bgpEnabled(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Workaround for not being able to set BGP and Name on "Request a server" request in API
 	updateRequest := cherrygo.UpdateServer{
 		Name: data.Name.ValueString(),
+		Bgp:  bgpEnabled.ValueBool(),
 	}
 
 	server, _, err = r.client.Servers.Update(server.ID, &updateRequest)
@@ -604,7 +816,7 @@ func (r *serverResource) Create(ctx context.Context, req resource.CreateRequest,
 		resp.Diagnostics.AddError("Unable to normalize CherryServers server image", err.Error())
 	}
 
-	data.populateModel(server, ctx, resp.Diagnostics, powerState.Power)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &data, server, powerState.Power)...)
 
 	// Write logs using the tflog package
 	tflog.SetField(ctx, "server_id", data.Id)
@@ -624,6 +836,11 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	// Deletion protection is not set on import.
+	if data.DeletionProtection.IsNull() {
+		data.DeletionProtection = types.BoolValue(false)
+	}
+
 	serverID, err := strconv.Atoi(data.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("invalid server ID in state", err.Error())
@@ -657,7 +874,7 @@ func (r *serverResource) Read(ctx context.Context, req resource.ReadRequest, res
 		resp.Diagnostics.AddError("Unable to normalize CherryServers server image", err.Error())
 	}
 
-	data.populateModel(server, ctx, resp.Diagnostics, powerState.Power)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &data, server, powerState.Power)...)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -690,14 +907,39 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	}
 
+	// The API always receives bgp, so the current value is sent unless the plan changes it.
+	planBGP, diags := plan.bgpEnabled(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateBGP, diags := state.bgpEnabled(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	bgpEnabled := stateBGP.ValueBool()
+	if !planBGP.IsUnknown() && !planBGP.IsNull() {
+		bgpEnabled = planBGP.ValueBool()
+	}
+
 	requestUpdate := cherrygo.UpdateServer{
 		Hostname: plan.Hostname.ValueString(),
 		Name:     plan.Name.ValueString(),
+		Bgp:      bgpEnabled,
 	}
 
-	if !plan.Tags.IsNull() && !plan.Tags.IsUnknown() {
-		tagsMap := make(map[string]string, len(plan.Tags.Elements()))
-		diags := plan.Tags.ElementsAs(ctx, &tagsMap, false)
+	if !plan.Tags.IsUnknown() {
+		// The API replaces all tags, so read the ignored ones to preserve them.
+		var currentTags map[string]string
+		if r.tags.hasIgnoreRules() {
+			current, _, err := r.client.Servers.Get(serverID, nil)
+			if err != nil {
+				resp.Diagnostics.AddError("unable to read a CherryServers server resource", err.Error())
+				return
+			}
+			currentTags = current.Tags
+		}
+
+		tagsMap, diags := r.tags.requestTags(ctx, plan.Tags, currentTags)
 		resp.Diagnostics.Append(diags...)
 
 		requestUpdate.Tags = &tagsMap
@@ -712,6 +954,24 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	updateTimeout, diags := plan.Timeouts.Update(ctx, 60*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.PowerState.IsUnknown() && !plan.PowerState.Equal(state.PowerState) {
+		if err = r.setPowerState(ctx, serverID, plan.PowerState.ValueString(), updateTimeout); err != nil {
+			addServerWaitError(&resp.Diagnostics, "unable to change CherryServers server power-state", serverID, err)
+			return
+		}
+	} else if !plan.RebootTrigger.Equal(state.RebootTrigger) && plan.PowerState.ValueString() != powerStateOff {
+		if err = r.reboot(ctx, serverID, updateTimeout); err != nil {
+			addServerWaitError(&resp.Diagnostics, "unable to reboot CherryServers server", serverID, err)
+			return
+		}
+	}
+
 	server, _, err = r.client.Servers.Get(serverID, nil)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -731,7 +991,7 @@ func (r *serverResource) Update(ctx context.Context, req resource.UpdateRequest,
 		resp.Diagnostics.AddError("Unable to normalize CherryServers server image", err.Error())
 	}
 
-	plan.populateModel(server, ctx, resp.Diagnostics, powerState.Power)
+	resp.Diagnostics.Append(r.populateWithTags(ctx, &plan, server, powerState.Power)...)
 
 	ctx = tflog.SetField(ctx, "server_id", plan.Id)
 	tflog.Trace(ctx, "updated a resource")
@@ -790,6 +1050,7 @@ func (r *serverResource) reinstall(ctx context.Context, plan serverResourceModel
 		return
 	}
 
+	lastStatus := ""
 	err = backoff.Retry(
 		func() error {
 			statusOption := cherrygo.GetOptions{Fields: []string{"status"}}
@@ -798,6 +1059,14 @@ func (r *serverResource) reinstall(ctx context.Context, plan serverResourceModel
 				return backoff.Permanent(e)
 			}
 
+			if s.Status != lastStatus {
+				tflog.Info(ctx, "server reinstall status changed", map[string]interface{}{
+					"server_id": server.ID,
+					"status":    s.Status,
+				})
+				lastStatus = s.Status
+			}
+
 			if s.Status == "deploying" {
 				return errors.New("server is in inactive state")
 			}
@@ -807,13 +1076,187 @@ func (r *serverResource) reinstall(ctx context.Context, plan serverResourceModel
 			}
 
 			return backoff.Permanent(errors.New("server is in unknown status"))
-		}, backoff.NewExponentialBackOff(
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
 			backoff.WithMaxElapsedTime(updateTimeout),
-			backoff.WithInitialInterval(time.Second*10)))
+			backoff.WithInitialInterval(time.Second*10)), ctx))
+	if err != nil {
+		addServerWaitError(&resp.Diagnostics, "unable to reinstall CherryServers server", server.ID, err)
+		return
+	}
+}
+
+// setPowerState powers the server on or off and waits for the power state to converge.
+func (r *serverResource) setPowerState(ctx context.Context, serverID int, powerState string, timeout time.Duration) error {
+	var err error
+	if powerState == powerStateOff {
+		_, _, err = r.client.Servers.PowerOff(serverID)
+	} else {
+		_, _, err = r.client.Servers.PowerOn(serverID)
+	}
 	if err != nil {
-		resp.Diagnostics.AddError("unable to reinstall CherryServers server", err.Error())
+		return err
+	}
+
+	tflog.Debug(ctx, "waiting for server power state", map[string]interface{}{
+		"server_id":   serverID,
+		"power_state": powerState,
+	})
+
+	return waitForServerPowerState(ctx, r.client, serverID, powerState, timeout)
+}
+
+// reboot reboots the server and waits for it to be powered on again.
+func (r *serverResource) reboot(ctx context.Context, serverID int, timeout time.Duration) error {
+	if _, _, err := r.client.Servers.Reboot(serverID); err != nil {
+		return err
+	}
+
+	tflog.Debug(ctx, "waiting for server reboot", map[string]interface{}{
+		"server_id": serverID,
+	})
+
+	return waitForServerReboot(ctx, r.client, serverID, timeout)
+}
+
+// waitForServerActive waits for a server to leave the pending and provisioning states.
+// It logs every state transition and stops early if ctx is cancelled.
+func waitForServerActive(ctx context.Context, client *cherrygo.Client, serverID int, timeout time.Duration) error {
+	lastState := ""
+	return backoff.Retry(
+		func() error {
+			stateOption := cherrygo.GetOptions{Fields: []string{"state"}}
+			s, _, e := client.Servers.Get(serverID, &stateOption)
+			if e != nil {
+				return backoff.Permanent(e)
+			}
+
+			if s.State != lastState {
+				tflog.Info(ctx, "server state changed", map[string]interface{}{
+					"server_id": serverID,
+					"state":     s.State,
+				})
+				lastState = s.State
+			}
+
+			if s.State == "pending" || s.State == "provisioning" {
+				return errors.New("server is in inactive state")
+			}
+
+			if s.State == "active" {
+				return nil
+			}
+
+			return backoff.Permanent(errors.New("failed to deploy server"))
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(timeout),
+			backoff.WithInitialInterval(time.Second*10)), ctx))
+}
+
+// waitForServerTerminated waits for a deleted server to disappear from the API.
+func waitForServerTerminated(ctx context.Context, client *cherrygo.Client, serverID int, timeout time.Duration) error {
+	return backoff.Retry(
+		func() error {
+			stateOption := cherrygo.GetOptions{Fields: []string{"state"}}
+			_, resp, e := client.Servers.Get(serverID, &stateOption)
+			if e != nil {
+				if resp != nil && is404Error(resp) {
+					return nil
+				}
+				return backoff.Permanent(e)
+			}
+
+			return errors.New("server is still terminating")
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(timeout),
+			backoff.WithInitialInterval(time.Second*10)), ctx))
+}
+
+// waitForServerPowerState waits for the server power state to converge to powerState.
+func waitForServerPowerState(ctx context.Context, client *cherrygo.Client, serverID int, powerState string, timeout time.Duration) error {
+	return backoff.Retry(
+		func() error {
+			p, _, e := client.Servers.PowerState(serverID)
+			if e != nil {
+				return backoff.Permanent(e)
+			}
+
+			if normalizePowerState(p.Power) != powerState {
+				return errors.New("server power state has not changed yet")
+			}
+
+			return nil
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(timeout),
+			backoff.WithInitialInterval(time.Second*5)), ctx))
+}
+
+// serverRebootStartWindow bounds the wait for a rebooting server to report leaving the powered on state.
+const serverRebootStartWindow = 30 * time.Second
+
+var errServerNotRebooting = errors.New("server has not started rebooting yet")
+
+// waitForServerReboot waits for a rebooting server to be powered on and active again.
+// The server is still powered on right after a reboot request, so it first waits a short while
+// for the server to leave the powered on state. A warm reboot may never report that, or may be
+// back on between two polls, so not seeing it is not an error.
+func waitForServerReboot(ctx context.Context, client *cherrygo.Client, serverID int, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := backoff.Retry(
+		func() error {
+			p, _, e := client.Servers.PowerState(serverID)
+			if e != nil {
+				return backoff.Permanent(e)
+			}
+
+			if normalizePowerState(p.Power) == powerStateOn {
+				return errServerNotRebooting
+			}
+
+			return nil
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(min(serverRebootStartWindow, timeout)),
+			backoff.WithInitialInterval(time.Second),
+			backoff.WithMaxInterval(time.Second*5)), ctx))
+	switch {
+	case errors.Is(err, errServerNotRebooting):
+		tflog.Debug(ctx, "server did not report a power state change after reboot", map[string]interface{}{
+			"server_id": serverID,
+		})
+	case err != nil:
+		return err
+	}
+
+	if err = waitForServerPowerState(ctx, client, serverID, powerStateOn, remainingTimeout(ctx, timeout)); err != nil {
+		return err
+	}
+
+	return waitForServerActive(ctx, client, serverID, remainingTimeout(ctx, timeout))
+}
+
+// remainingTimeout returns the time left until the ctx deadline, capped at timeout.
+// It splits one deadline between several consecutive waits.
+func remainingTimeout(ctx context.Context, timeout time.Duration) time.Duration {
+	if deadline, ok := ctx.Deadline(); ok {
+		return min(timeout, time.Until(deadline))
+	}
+	return timeout
+}
+
+// addServerWaitError adds a diagnostic for a failed server wait.
+// Cancellation gets its own summary, so it is not mistaken for a failed deployment.
+func addServerWaitError(diags *diag.Diagnostics, summary string, serverID int, err error) {
+	if errors.Is(err, context.Canceled) {
+		diags.AddError(
+			"CherryServers server operation interrupted",
+			fmt.Sprintf("Waiting for server %d was cancelled. The server ID is kept in the Terraform state, "+
+				"so the server is not orphaned; check its status before the next apply.", serverID),
+		)
 		return
 	}
+
+	diags.AddError(summary, fmt.Sprintf("server %d: %s", serverID, err))
 }
 
 func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -826,6 +1269,10 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if deletionProtectionEnabled(data.DeletionProtection, resp, "server") {
+		return
+	}
+
 	serverID, _ := strconv.Atoi(data.Id.ValueString())
 
 	if _, _, err := r.client.Servers.Delete(serverID); err != nil {
@@ -836,6 +1283,17 @@ func (r *serverResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 20*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := waitForServerTerminated(ctx, r.client, serverID, deleteTimeout); err != nil {
+		addServerWaitError(&resp.Diagnostics, "error waiting for a CherryServers server to terminate", serverID, err)
+		return
+	}
+
 	ctx = tflog.SetField(ctx, "server_id", data.Id)
 	tflog.Trace(ctx, "deleted a resource")
 }
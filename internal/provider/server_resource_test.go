@@ -108,6 +108,73 @@ func TestAccServerResource_fullConfig(t *testing.T) {
 	})
 }
 
+func TestAccServerResource_powerState(t *testing.T) {
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	teamID := os.Getenv("CHERRY_TEST_TEAM_ID")
+	const resourceName = "cherryservers_server.test_power_server"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerResourcePowerConfig(projectName, teamID, "off", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "power_state", "off"),
+				),
+			},
+			{
+				Config: testAccServerResourcePowerConfig(projectName, teamID, "on", "initial"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "on"),
+				),
+			},
+			{
+				Config: testAccServerResourcePowerConfig(projectName, teamID, "on", "reboot"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "power_state", "on"),
+					resource.TestCheckResourceAttr(resourceName, "reboot_trigger", "reboot"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServerResource_bgp(t *testing.T) {
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	teamID := os.Getenv("CHERRY_TEST_TEAM_ID")
+	const resourceName = "cherryservers_server.test_bgp_server"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersServerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServerResourceBGPConfig(projectName, teamID, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersServerExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "bgp.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "bgp.local_asn", "cherryservers_project.test_bgp_project", "bgp.local_asn"),
+				),
+			},
+			// BGP is left unchanged when the bgp attribute is removed from the configuration.
+			{
+				Config: testAccServerResourceBGPOmittedConfig(projectName, teamID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bgp.enabled", "true"),
+				),
+			},
+			{
+				Config: testAccServerResourceBGPConfig(projectName, teamID, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bgp.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckCherryServersServerExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -156,9 +223,7 @@ func testAccCheckCherryServersServerDestroy(s *terraform.State) error {
 			return fmt.Errorf("server listing error: %#v", err)
 		}
 
-		if server.State != "terminating" {
-			return fmt.Errorf("server state is not terminating: %s", server.State)
-		}
+		return fmt.Errorf("server still exists in state: %s", server.State)
 	}
 	return nil
 }
@@ -268,8 +333,65 @@ resource "cherryservers_server" "test_server_server" {
   timeouts = {
     create = "20m"
 	update = "10m"
+	delete = "20m"
   }
   allow_reinstall = true
 }
 `, projectName, teamID, sshKeyLabel, sshKeyPublicKey)
 }
+
+func testAccServerResourcePowerConfig(projectName string, teamID string, powerState string, rebootTrigger string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_power_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_power_server" {
+  region = "LT-Siauliai"
+  plan = "B1-1-1gb-20s-shared"
+  project_id = "${cherryservers_project.test_power_project.id}"
+  power_state = "%s"
+  reboot_trigger = "%s"
+}
+`, projectName, teamID, powerState, rebootTrigger)
+}
+
+func testAccServerResourceBGPConfig(projectName string, teamID string, bgpEnabled bool) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_bgp_project" {
+  name = "%s"
+  team_id = "%s"
+  bgp = {
+    enabled = true
+  }
+}
+
+resource "cherryservers_server" "test_bgp_server" {
+  region = "LT-Siauliai"
+  plan = "B1-1-1gb-20s-shared"
+  project_id = "${cherryservers_project.test_bgp_project.id}"
+  bgp = {
+    enabled = %t
+  }
+}
+`, projectName, teamID, bgpEnabled)
+}
+
+func testAccServerResourceBGPOmittedConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_bgp_project" {
+  name = "%s"
+  team_id = "%s"
+  bgp = {
+    enabled = true
+  }
+}
+
+resource "cherryservers_server" "test_bgp_server" {
+  region = "LT-Siauliai"
+  plan = "B1-1-1gb-20s-shared"
+  project_id = "${cherryservers_project.test_bgp_project.id}"
+}
+`, projectName, teamID)
+}
@@ -0,0 +1,340 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/cenkalti/backoff/v4"
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                     = &storageAttachmentResource{}
+	_ resource.ResourceWithConfigure        = &storageAttachmentResource{}
+	_ resource.ResourceWithImportState      = &storageAttachmentResource{}
+	_ resource.ResourceWithConfigValidators = &storageAttachmentResource{}
+)
+
+func NewStorageAttachmentResource() resource.Resource {
+	return &storageAttachmentResource{}
+}
+
+// storageAttachmentResource defines the resource implementation.
+type storageAttachmentResource struct {
+	client *cherrygo.Client
+}
+
+// storageAttachmentResourceModel describes the resource data model.
+type storageAttachmentResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	StorageId      types.String   `tfsdk:"storage_id"`
+	ServerId       types.String   `tfsdk:"server_id"`
+	ServerHostname types.String   `tfsdk:"server_hostname"`
+	ProjectId      types.Int64    `tfsdk:"project_id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (d *storageAttachmentResourceModel) populateState(storage cherrygo.BlockStorage) {
+	d.Id = types.StringValue(strconv.Itoa(storage.ID))
+	d.StorageId = types.StringValue(strconv.Itoa(storage.ID))
+	d.ServerId = types.StringValue(strconv.Itoa(storage.AttachedTo.ID))
+	d.ServerHostname = types.StringValue(storage.AttachedTo.Hostname)
+}
+
+func (r *storageAttachmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage_attachment"
+}
+
+func (r *storageAttachmentResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(path.MatchRoot("server_id"), path.MatchRoot("server_hostname")),
+	}
+}
+
+func (r *storageAttachmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers storage attachment resource. This can be used to attach elastic block storage volumes to servers.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Storage attachment identifier. Equal to the attached storage ID.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"storage_id": schema.StringAttribute{
+				Description: "ID of the storage volume to attach.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_id": schema.StringAttribute{
+				Description: "ID of the server to attach the storage to. " +
+					"Conflicts with server_hostname.",
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					UseStateIfNoConfigurationChanges(path.Expressions{
+						path.MatchRoot("server_hostname"),
+					}...),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"server_hostname": schema.StringAttribute{
+				Description: "Hostname of the server to attach the storage to. " +
+					"Conflicts with server_id. Requires project_id.",
+				Optional: true,
+				Computed: true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("project_id")),
+				},
+				PlanModifiers: []planmodifier.String{
+					UseStateIfNoConfigurationChanges(path.Expressions{
+						path.MatchRoot("server_id"),
+					}...),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, used to look up the server by hostname.",
+				Optional:    true,
+			},
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *storageAttachmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *storageAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data storageAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.StorageId.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID", err.Error())
+		return
+	}
+
+	serverID, err := data.getServerId(r)
+	if err != nil {
+		resp.Diagnostics.AddError("invalid server ID or hostname", err.Error())
+		return
+	}
+
+	request := &cherrygo.AttachTo{
+		StorageID: storageID,
+		AttachTo:  serverID,
+	}
+
+	if _, _, err = r.client.Storages.Attach(request); err != nil {
+		resp.Diagnostics.AddError("unable to attach a CherryServers storage", err.Error())
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var storage cherrygo.BlockStorage
+	err = backoff.Retry(
+		func() error {
+			var e error
+			storage, _, e = r.client.Storages.Get(storageID, nil)
+			if e != nil {
+				return backoff.Permanent(e)
+			}
+
+			if storage.AttachedTo.ID != serverID {
+				return errors.New("storage is not attached yet")
+			}
+
+			return nil
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(createTimeout),
+			backoff.WithInitialInterval(time.Second*5)), ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to attach a CherryServers storage", err.Error())
+		return
+	}
+
+	data.populateState(storage)
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "storage_id", data.StorageId)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *storageAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data storageAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID in state", err.Error())
+		return
+	}
+
+	storage, storageGetResp, err := r.client.Storages.Get(storageID, nil)
+	if err != nil {
+		if is404Error(storageGetResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers storage attachment",
+			err.Error(),
+		)
+		return
+	}
+
+	// The storage has been detached outside of Terraform.
+	if storage.AttachedTo.ID == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.populateState(storage)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only handles attributes that do not require re-attaching the storage.
+func (r *storageAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data storageAttachmentResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "storage_id", data.StorageId)
+	tflog.Trace(ctx, "updated a resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *storageAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data storageAttachmentResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID in state", err.Error())
+		return
+	}
+
+	if detachResp, err := r.client.Storages.Detach(storageID); err != nil {
+		if is404Error(detachResp) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to detach a CherryServers storage",
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, 10*time.Minute)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err = backoff.Retry(
+		func() error {
+			storage, storageGetResp, e := r.client.Storages.Get(storageID, nil)
+			if e != nil {
+				if is404Error(storageGetResp) {
+					return nil
+				}
+				return backoff.Permanent(e)
+			}
+
+			if storage.AttachedTo.ID != 0 {
+				return errors.New("storage is not detached yet")
+			}
+
+			return nil
+		}, backoff.WithContext(backoff.NewExponentialBackOff(
+			backoff.WithMaxElapsedTime(deleteTimeout),
+			backoff.WithInitialInterval(time.Second*5)), ctx))
+	if err != nil {
+		resp.Diagnostics.AddError("unable to detach a CherryServers storage", err.Error())
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "storage_id", data.StorageId)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+func (r *storageAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (d *storageAttachmentResourceModel) getServerId(r *storageAttachmentResource) (int, error) {
+	if d.ServerId.ValueString() != "" {
+		serverID, err := strconv.Atoi(d.ServerId.ValueString())
+		if err != nil {
+			return 0, fmt.Errorf("invalid server ID %q: %w", d.ServerId.ValueString(), err)
+		}
+		return serverID, nil
+	}
+
+	return serverHostnameToID(d.ServerHostname.ValueString(), int(d.ProjectId.ValueInt64()), r.client.Servers)
+}
@@ -0,0 +1,87 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStorageAttachmentResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_storage_attachment.test_attachment"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStorageAttachmentResourceConfig(projectName, teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersStorageAttached(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "storage_id", "cherryservers_storage.test_attachment_storage", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "server_id", "cherryservers_server.test_attachment_server", "id"),
+					resource.TestMatchResourceAttr(resourceName, "server_hostname", regexp.MustCompile("[a-z]+-[a-z]+")),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"project_id"},
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccStorageAttachmentResourceConfig(projectName string, teamID string) string {
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_attachment_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_server" "test_attachment_server" {
+  plan = "B1-1-1gb-20s-shared"
+  region = "LT-Siauliai"
+  project_id = "${cherryservers_project.test_attachment_project.id}"
+}
+
+resource "cherryservers_storage" "test_attachment_storage" {
+  project_id = "${cherryservers_project.test_attachment_project.id}"
+  region = "LT-Siauliai"
+  size = 10
+}
+
+resource "cherryservers_storage_attachment" "test_attachment" {
+  storage_id = "${cherryservers_storage.test_attachment_storage.id}"
+  server_id = "${cherryservers_server.test_attachment_server.id}"
+}
+`, projectName, teamID)
+}
+
+func testAccCheckCherryServersStorageAttached(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		storageID, err := testAccGetResourceIdInt(resourceName, "storage attachment", s)
+		if err != nil {
+			return err
+		}
+
+		storage, _, err := testCherryGoClient.Storages.Get(storageID, nil)
+		if err != nil {
+			return err
+		}
+
+		if storage.AttachedTo.ID == 0 {
+			return fmt.Errorf("storage %d is not attached", storageID)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,378 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &storageResource{}
+	_ resource.ResourceWithConfigure   = &storageResource{}
+	_ resource.ResourceWithImportState = &storageResource{}
+)
+
+func NewStorageResource() resource.Resource {
+	return &storageResource{}
+}
+
+// storageResource defines the resource implementation.
+type storageResource struct {
+	client *cherrygo.Client
+}
+
+// storageResourceModel describes the resource data model.
+type storageResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	ProjectId   types.Int64  `tfsdk:"project_id"`
+	Region      types.String `tfsdk:"region"`
+	Size        types.Int64  `tfsdk:"size"`
+	Description types.String `tfsdk:"description"`
+	Name        types.String `tfsdk:"name"`
+	Unit        types.String `tfsdk:"unit"`
+	VlanID      types.String `tfsdk:"vlan_id"`
+	VlanIP      types.String `tfsdk:"vlan_ip"`
+	Initiator   types.String `tfsdk:"initiator"`
+	DiscoveryIP types.String `tfsdk:"discovery_ip"`
+}
+
+func (d *storageResourceModel) populateState(storage cherrygo.BlockStorage) {
+	d.Id = types.StringValue(strconv.Itoa(storage.ID))
+	d.Region = types.StringValue(storage.Region.Slug)
+	d.Size = types.Int64Value(int64(storage.Size))
+	// The API returns an empty description if it is not set.
+	d.Description = types.StringNull()
+	if storage.Description != "" {
+		d.Description = types.StringValue(storage.Description)
+	}
+	d.Name = types.StringValue(storage.Name)
+	d.Unit = types.StringValue(storage.Unit)
+	d.VlanID = types.StringValue(storage.VlanID)
+	d.VlanIP = types.StringValue(storage.VlanIP)
+	d.Initiator = types.StringValue(storage.Initiator)
+	d.DiscoveryIP = types.StringValue(storage.DiscoveryIP)
+}
+
+func (r *storageResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_storage"
+}
+
+func (r *storageResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers elastic block storage resource. This can be used to create, resize, and delete storage volumes.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: "Storage identifier.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"project_id": schema.Int64Attribute{
+				Description: "CherryServers project id, associated with the storage.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"region": schema.StringAttribute{
+				Description: "Slug of the region. Example: LT-Siauliai [See List Regions](https://api.cherryservers.com/doc/#tag/Regions/operation/get-regions).",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"size": schema.Int64Attribute{
+				Description: "Size of the storage volume in GB. " +
+					"Volumes can be grown in place, decreasing the size requires replacing the volume.",
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplaceIf(
+						func(ctx context.Context, req planmodifier.Int64Request, resp *int64planmodifier.RequiresReplaceIfFuncResponse) {
+							resp.RequiresReplace = req.PlanValue.ValueInt64() < req.StateValue.ValueInt64()
+						},
+						"Decreasing the storage size requires replacing the volume.",
+						"Decreasing the storage size requires replacing the volume.",
+					),
+				},
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the storage volume.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the storage volume, assigned by the API.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"unit": schema.StringAttribute{
+				Description: "Storage size measurement unit.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_id": schema.StringAttribute{
+				Description: "ID of the VLAN the storage volume is exposed on.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vlan_ip": schema.StringAttribute{
+				Description: "IP address of the storage volume in its VLAN.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"initiator": schema.StringAttribute{
+				Description: "iSCSI initiator name used to connect to the storage volume.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"discovery_ip": schema.StringAttribute{
+				Description: "iSCSI discovery IP address of the storage volume.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *storageResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	r.client = DefaultClientConfigure(req, resp)
+}
+
+func (r *storageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data storageResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	request := &cherrygo.CreateStorage{
+		ProjectID:   int(data.ProjectId.ValueInt64()),
+		Description: data.Description.ValueString(),
+		Size:        int(data.Size.ValueInt64()),
+		Region:      data.Region.ValueString(),
+	}
+
+	storage, _, err := r.client.Storages.Create(request)
+	if err != nil {
+		resp.Diagnostics.AddError("unable to create a CherryServers storage resource", err.Error())
+		return
+	}
+
+	storage, _, err = r.client.Storages.Get(storage.ID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	data.populateState(storage)
+
+	// Write logs using the tflog package
+	ctx = tflog.SetField(ctx, "storage_id", data.Id)
+	tflog.Trace(ctx, "created a resource")
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *storageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data storageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID in state", err.Error())
+		return
+	}
+
+	storage, storageGetResp, err := r.client.Storages.Get(storageID, nil)
+	if err != nil {
+		if is404Error(storageGetResp) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	data.populateState(storage)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *storageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state storageResourceModel
+
+	// Read Terraform plan and state data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID in state", err.Error())
+		return
+	}
+
+	request := &cherrygo.UpdateStorage{
+		StorageID:   storageID,
+		Size:        int(data.Size.ValueInt64()),
+		Description: data.Description.ValueString(),
+	}
+
+	if data.Description.IsNull() && !state.Description.IsNull() {
+		err = r.clearDescription(request)
+	} else {
+		_, _, err = r.client.Storages.Update(request)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to update a CherryServers storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	storage, _, err := r.client.Storages.Get(storageID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"unable to read a CherryServers storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	data.populateState(storage)
+
+	ctx = tflog.SetField(ctx, "storage_id", data.Id)
+	tflog.Trace(ctx, "updated a resource")
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *storageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data storageResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	storageID, err := strconv.Atoi(data.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("invalid storage ID in state", err.Error())
+		return
+	}
+
+	if _, err = r.client.Storages.Delete(storageID); err != nil {
+		resp.Diagnostics.AddError(
+			"unable to delete a CherryServers storage resource",
+			err.Error(),
+		)
+		return
+	}
+
+	ctx = tflog.SetField(ctx, "storage_id", data.Id)
+	tflog.Trace(ctx, "deleted a resource")
+}
+
+// clearDescription updates the storage volume and removes its description.
+// cherrygo.UpdateStorage omits an empty description, so the request is built here.
+func (r *storageResource) clearDescription(request *cherrygo.UpdateStorage) error {
+	body := struct {
+		Size        int    `json:"size"`
+		Description string `json:"description"`
+	}{Size: request.Size}
+
+	req, err := r.client.NewRequest(http.MethodPut, fmt.Sprintf("/v1/storages/%d", request.StorageID), body)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.client.Do(req, nil)
+	return err
+}
+
+// ImportState workaround for storage not knowing its projectID.
+func (r *storageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: project_id,storage_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	projectID, err := strconv.Atoi(idParts[0])
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid project_id Import Identifier", idParts[0])
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("project_id"), projectID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
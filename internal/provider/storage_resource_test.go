@@ -0,0 +1,123 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccStorageResource_basic(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	projectName := testProjectNamePrefix + acctest.RandString(5)
+	const resourceName = "cherryservers_storage.test_storage"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccCheckCherryServersStorageDestroy,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccStorageResourceConfig(projectName, teamId, 10, "test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersStorageExists(resourceName),
+					resource.TestMatchResourceAttr(resourceName, "id", regexp.MustCompile("[0-9]+")),
+					resource.TestCheckResourceAttr(resourceName, "size", "10"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test"),
+					resource.TestCheckResourceAttr(resourceName, "region", "LT-Siauliai"),
+					resource.TestCheckResourceAttrSet(resourceName, "name"),
+					resource.TestCheckResourceAttrSet(resourceName, "unit"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources[resourceName]
+					if !ok {
+						return "", fmt.Errorf("resource not found: %s", resourceName)
+					}
+					return rs.Primary.Attributes["project_id"] + "," + rs.Primary.ID, nil
+				},
+			},
+			// Update and Read testing
+			{
+				Config: testAccStorageResourceConfig(projectName, teamId, 20, "test-resize"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersStorageExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "size", "20"),
+					resource.TestCheckResourceAttr(resourceName, "description", "test-resize"),
+				),
+			},
+			// Removing the description from the configuration clears it
+			{
+				Config: testAccStorageResourceConfig(projectName, teamId, 20, ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckCherryServersStorageExists(resourceName),
+					resource.TestCheckNoResourceAttr(resourceName, "description"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+// testAccStorageResourceConfig leaves the description out if it is empty.
+func testAccStorageResourceConfig(projectName string, teamID string, size int, description string) string {
+	descriptionArg := ""
+	if description != "" {
+		descriptionArg = fmt.Sprintf("description = %q", description)
+	}
+
+	return fmt.Sprintf(`
+resource "cherryservers_project" "test_storage_project" {
+  name = "%s"
+  team_id = "%s"
+}
+
+resource "cherryservers_storage" "test_storage" {
+  project_id = "${cherryservers_project.test_storage_project.id}"
+  region = "LT-Siauliai"
+  size = %d
+  %s
+}
+`, projectName, teamID, size, descriptionArg)
+}
+
+func testAccCheckCherryServersStorageExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		storageID, err := testAccGetResourceIdInt(resourceName, "storage", s)
+		if err != nil {
+			return err
+		}
+
+		// Try to get the storage
+		_, _, err = testCherryGoClient.Storages.Get(storageID, nil)
+		return err
+	}
+}
+
+func testAccCheckCherryServersStorageDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "cherryservers_storage" {
+			continue
+		}
+
+		storageID, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("unable to convert storage ID")
+		}
+
+		if _, resp, err := testCherryGoClient.Storages.Get(storageID, nil); err == nil || !is404Error(resp) {
+			return fmt.Errorf("storage %d still exists", storageID)
+		}
+	}
+	return nil
+}
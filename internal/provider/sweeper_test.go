@@ -36,6 +36,9 @@ func sharedClient() (any, error) {
 	//Make user agent version responsive.
 	userAgent := fmt.Sprintf("terraform-provider/cherryservers/%s terraform/%s", "test", "1.0.0")
 	args := []cherrygo.ClientOpt{cherrygo.WithAuthToken(apiKey), cherrygo.WithUserAgent(userAgent)}
+	if apiURL := os.Getenv("CHERRY_API_URL"); apiURL != "" {
+		args = append(args, cherrygo.WithURL(apiURL))
+	}
 	client, err := cherrygo.NewClient(args...)
 	if err != nil {
 		return nil, err
@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// tagsConfig holds the provider-wide tag settings of taggable resources.
+type tagsConfig struct {
+	// defaultTags are merged into the tags of every taggable resource.
+	defaultTags map[string]string
+	// ignoreKeys and ignoreKeyPrefixes match externally managed tags,
+	// which are left out of the state and preserved on update.
+	ignoreKeys        []string
+	ignoreKeyPrefixes []string
+}
+
+// hasIgnoreRules reports whether any tags are ignored.
+func (c tagsConfig) hasIgnoreRules() bool {
+	return len(c.ignoreKeys) > 0 || len(c.ignoreKeyPrefixes) > 0
+}
+
+// ignored reports whether the tag key is externally managed.
+func (c tagsConfig) ignored(key string) bool {
+	for _, k := range c.ignoreKeys {
+		if key == k {
+			return true
+		}
+	}
+	for _, prefix := range c.ignoreKeyPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// defaults returns the default tags that are not ignored.
+func (c tagsConfig) defaults() map[string]string {
+	defaults := make(map[string]string, len(c.defaultTags))
+	for k, v := range c.defaultTags {
+		if !c.ignored(k) {
+			defaults[k] = v
+		}
+	}
+	return defaults
+}
+
+// mergeTags returns the base tags overridden by the resource tags.
+func mergeTags(base, tags map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(tags))
+	for k, v := range base {
+		merged[k] = v
+	}
+	for k, v := range tags {
+		merged[k] = v
+	}
+	return merged
+}
+
+// requestTags returns the tags to send to the API for the planned resource tags.
+// The ignored tags of current, the tags the resource has in the API, are preserved.
+func (c tagsConfig) requestTags(ctx context.Context, tags types.Map, current map[string]string) (map[string]string, diag.Diagnostics) {
+	tagsMap := make(map[string]string, len(tags.Elements()))
+	diags := tags.ElementsAs(ctx, &tagsMap, false)
+
+	base := c.defaults()
+	for k, v := range current {
+		if c.ignored(k) {
+			base[k] = v
+		}
+	}
+
+	return mergeTags(base, tagsMap), diags
+}
+
+// planTagsAll returns the planned tags_all value for the planned resource tags.
+func (c tagsConfig) planTagsAll(ctx context.Context, tags types.Map) (types.Map, diag.Diagnostics) {
+	if tags.IsUnknown() {
+		return types.MapUnknown(types.StringType), nil
+	}
+
+	tagsMap := make(map[string]string, len(tags.Elements()))
+	diags := tags.ElementsAs(ctx, &tagsMap, false)
+	if diags.HasError() {
+		return types.MapNull(types.StringType), diags
+	}
+
+	tagsAll, mapDiags := types.MapValueFrom(ctx, types.StringType, mergeTags(c.defaults(), tagsMap))
+	diags.Append(mapDiags...)
+	return tagsAll, diags
+}
+
+// stateTags returns the tags and tags_all state values for the tags returned by the API.
+// Tags already in prior are kept in tags. Other tags equal to a provider default tag are
+// left out of tags, so that it only holds the tags set on the resource itself, and
+// ignored tags are left out of both.
+func (c tagsConfig) stateTags(ctx context.Context, apiTags map[string]string, prior types.Map) (types.Map, types.Map, diag.Diagnostics) {
+	priorMap := make(map[string]string, len(prior.Elements()))
+	var diags diag.Diagnostics
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorMap, false)...)
+	}
+
+	defaults := c.defaults()
+	tagsMap := make(map[string]string, len(apiTags))
+	tagsAllMap := make(map[string]string, len(apiTags))
+	for k, v := range apiTags {
+		if pv, ok := priorMap[k]; ok && pv == v {
+			tagsMap[k] = v
+			tagsAllMap[k] = v
+			continue
+		}
+
+		if c.ignored(k) {
+			continue
+		}
+		tagsAllMap[k] = v
+
+		if dv, ok := defaults[k]; !ok || dv != v {
+			tagsMap[k] = v
+		}
+	}
+
+	tags, mapDiags := types.MapValueFrom(ctx, types.StringType, tagsMap)
+	diags.Append(mapDiags...)
+	tagsAll, mapDiags := types.MapValueFrom(ctx, types.StringType, tagsAllMap)
+	diags.Append(mapDiags...)
+
+	return tags, tagsAll, diags
+}
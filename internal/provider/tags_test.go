@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestMergeTags(t *testing.T) {
+	got := mergeTags(map[string]string{"env": "dev", "owner": "ops"}, map[string]string{"env": "prod"})
+	want := map[string]string{"env": "prod", "owner": "ops"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeTags() = %v, want %v", got, want)
+	}
+}
+
+func TestStateTags(t *testing.T) {
+	ctx := context.Background()
+	cfg := tagsConfig{defaultTags: map[string]string{"env": "dev", "owner": "ops"}}
+	apiTags := map[string]string{"env": "dev", "owner": "sre", "name": "web"}
+
+	cases := []struct {
+		name  string
+		prior map[string]string
+		want  map[string]string
+	}{
+		{name: "defaults left out", prior: map[string]string{"owner": "sre", "name": "web"}, want: map[string]string{"owner": "sre", "name": "web"}},
+		{name: "default set on the resource kept", prior: map[string]string{"env": "dev"}, want: map[string]string{"env": "dev", "owner": "sre", "name": "web"}},
+		{name: "import", want: map[string]string{"owner": "sre", "name": "web"}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			prior := types.MapNull(types.StringType)
+			if c.prior != nil {
+				prior, _ = types.MapValueFrom(ctx, types.StringType, c.prior)
+			}
+
+			tags, tagsAll, diags := cfg.stateTags(ctx, apiTags, prior)
+			if diags.HasError() {
+				t.Fatal(diags)
+			}
+
+			gotTags := make(map[string]string)
+			tags.ElementsAs(ctx, &gotTags, false)
+			if !reflect.DeepEqual(gotTags, c.want) {
+				t.Errorf("tags = %v, want %v", gotTags, c.want)
+			}
+
+			gotTagsAll := make(map[string]string)
+			tagsAll.ElementsAs(ctx, &gotTagsAll, false)
+			if !reflect.DeepEqual(gotTagsAll, apiTags) {
+				t.Errorf("tags_all = %v, want %v", gotTagsAll, apiTags)
+			}
+		})
+	}
+}
+
+func TestTagsConfig_ignored(t *testing.T) {
+	ctx := context.Background()
+	cfg := tagsConfig{
+		defaultTags:       map[string]string{"env": "dev", "billing": "auto"},
+		ignoreKeys:        []string{"billing"},
+		ignoreKeyPrefixes: []string{"monitoring:"},
+	}
+	apiTags := map[string]string{"env": "dev", "billing": "123", "monitoring:agent": "1", "name": "web"}
+
+	prior, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"name": "web"})
+	tags, tagsAll, diags := cfg.stateTags(ctx, apiTags, prior)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+
+	gotTags := make(map[string]string)
+	tags.ElementsAs(ctx, &gotTags, false)
+	if want := map[string]string{"name": "web"}; !reflect.DeepEqual(gotTags, want) {
+		t.Errorf("tags = %v, want %v", gotTags, want)
+	}
+
+	gotTagsAll := make(map[string]string)
+	tagsAll.ElementsAs(ctx, &gotTagsAll, false)
+	if want := map[string]string{"env": "dev", "name": "web"}; !reflect.DeepEqual(gotTagsAll, want) {
+		t.Errorf("tags_all = %v, want %v", gotTagsAll, want)
+	}
+
+	planned, _ := types.MapValueFrom(ctx, types.StringType, map[string]string{"name": "api"})
+	request, diags := cfg.requestTags(ctx, planned, apiTags)
+	if diags.HasError() {
+		t.Fatal(diags)
+	}
+	if want := map[string]string{"env": "dev", "billing": "123", "monitoring:agent": "1", "name": "api"}; !reflect.DeepEqual(request, want) {
+		t.Errorf("request tags = %v, want %v", request, want)
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type teamCreditDetailsModel struct {
+	Remaining types.Float32 `tfsdk:"remaining"`
+	Usage     types.Float32 `tfsdk:"usage"`
+	Currency  types.String  `tfsdk:"currency"`
+}
+
+func teamCreditDetailsAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"remaining": types.Float32Type,
+		"usage":     types.Float32Type,
+		"currency":  types.StringType,
+	}
+}
+
+type teamCreditModel struct {
+	Account types.Object `tfsdk:"account"`
+	Promo   types.Object `tfsdk:"promo"`
+}
+
+func teamCreditAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"account": types.ObjectType{AttrTypes: teamCreditDetailsAttributeTypes()},
+		"promo":   types.ObjectType{AttrTypes: teamCreditDetailsAttributeTypes()},
+	}
+}
+
+type teamModel struct {
+	ID       types.Int64  `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Type     types.String `tfsdk:"type"`
+	Currency types.String `tfsdk:"currency"`
+	Href     types.String `tfsdk:"href"`
+	Credit   types.Object `tfsdk:"credit"`
+	Projects types.List   `tfsdk:"projects"`
+}
+
+func teamAttributeTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"id":       types.Int64Type,
+		"name":     types.StringType,
+		"type":     types.StringType,
+		"currency": types.StringType,
+		"href":     types.StringType,
+		"credit":   types.ObjectType{AttrTypes: teamCreditAttributeTypes()},
+		"projects": types.ListType{ElemType: types.ObjectType{AttrTypes: projectAttributeTypes()}},
+	}
+}
+
+func (m *teamModel) populateState(ctx context.Context, team cherrygo.Team) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.Int64Value(int64(team.ID))
+	m.Name = types.StringValue(team.Name)
+	m.Type = types.StringValue(team.Billing.Type)
+	m.Currency = types.StringValue(team.Billing.Currency)
+	m.Href = types.StringValue(team.Href)
+
+	account, d := types.ObjectValueFrom(ctx, teamCreditDetailsAttributeTypes(), teamCreditDetailsModel{
+		Remaining: types.Float32Value(team.Credit.Account.Remaining),
+		Usage:     types.Float32Value(team.Credit.Account.Usage),
+		Currency:  types.StringValue(team.Credit.Account.Currency),
+	})
+	diags.Append(d...)
+
+	promo, d := types.ObjectValueFrom(ctx, teamCreditDetailsAttributeTypes(), teamCreditDetailsModel{
+		Remaining: types.Float32Value(team.Credit.Promo.Remaining),
+		Usage:     types.Float32Value(team.Credit.Promo.Usage),
+		Currency:  types.StringValue(team.Credit.Promo.Currency),
+	})
+	diags.Append(d...)
+
+	credit, d := types.ObjectValueFrom(ctx, teamCreditAttributeTypes(), teamCreditModel{
+		Account: account,
+		Promo:   promo,
+	})
+	diags.Append(d...)
+	m.Credit = credit
+
+	projectModels := make([]projectModel, len(team.Projects))
+	for i, v := range team.Projects {
+		diags.Append(projectModels[i].populateState(ctx, v)...)
+	}
+
+	projects, d := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: projectAttributeTypes()}, projectModels)
+	diags.Append(d...)
+	m.Projects = projects
+
+	return diags
+}
+
+// findTeam returns the team identified by ID or, if the ID is not set, by name.
+func (m *teamModel) findTeam(client *cherrygo.Client) (cherrygo.Team, error) {
+	id := m.ID.ValueInt64()
+	name := m.Name.ValueString()
+
+	if id != 0 {
+		team, _, err := client.Teams.Get(int(id), nil)
+		return team, err
+	}
+	if name == "" {
+		return cherrygo.Team{}, errors.New("unidentifiable team, no name or ID set")
+	}
+
+	teams, _, err := client.Teams.List(nil)
+	if err != nil {
+		return cherrygo.Team{}, err
+	}
+
+	for _, team := range teams {
+		if team.Name == name {
+			return team, nil
+		}
+	}
+
+	return cherrygo.Team{}, errors.New("could not find team with `" + name + "` name")
+}
+
+func creditDetailsSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Attributes: map[string]schema.Attribute{
+			"remaining": schema.Float32Attribute{
+				Computed:    true,
+				Description: "Remaining credit.",
+			},
+			"usage": schema.Float32Attribute{
+				Computed:    true,
+				Description: "Used credit.",
+			},
+			"currency": schema.StringAttribute{
+				Computed:    true,
+				Description: "Credit currency.",
+			},
+		},
+		Computed:    true,
+		Description: description,
+	}
+}
+
+func teamSchema(readOnly bool) map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.Int64Attribute{
+			Optional:    !readOnly,
+			Computed:    true,
+			Description: "Team ID.",
+		},
+		"name": schema.StringAttribute{
+			Optional:    !readOnly,
+			Computed:    true,
+			Description: "Team name.",
+		},
+		"type": schema.StringAttribute{
+			Computed:    true,
+			Description: "Team billing type, such as 'personal' or 'business'.",
+		},
+		"currency": schema.StringAttribute{
+			Computed:    true,
+			Description: "Team billing currency.",
+		},
+		"href": schema.StringAttribute{
+			Computed:    true,
+			Description: "The API link to the team.",
+		},
+		"credit": schema.SingleNestedAttribute{
+			Attributes: map[string]schema.Attribute{
+				"account": creditDetailsSchema("Team account credit."),
+				"promo":   creditDetailsSchema("Team promotional credit."),
+			},
+			Computed:    true,
+			Description: "Team credit data.",
+		},
+		"projects": schema.ListNestedAttribute{
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: projectSchema(),
+			},
+			Computed:    true,
+			Description: "Projects that belong to the team.",
+		},
+	}
+}
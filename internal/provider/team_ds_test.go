@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTeamSingleDSByID(t *testing.T) {
+	teamId := os.Getenv("CHERRY_TEST_TEAM_ID")
+	const dsName = "data.cherryservers_team.by_id"
+	const byNameDSName = "data.cherryservers_team.by_name"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccTeamSingleDSConfig(teamId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dsName, "id", teamId),
+					resource.TestCheckResourceAttrSet(dsName, "name"),
+					resource.TestCheckResourceAttrSet(dsName, "type"),
+					resource.TestCheckResourceAttrSet(dsName, "credit.account.currency"),
+					resource.TestCheckResourceAttrPair(byNameDSName, "id", dsName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamSingleDSConfig(teamID string) string {
+	return fmt.Sprintf(`
+data "cherryservers_team" "by_id" {
+  id = %s
+}
+
+data "cherryservers_team" "by_name" {
+  name = data.cherryservers_team.by_id.name
+}
+`, teamID)
+}
+
+func TestAccTeamsList(t *testing.T) {
+	const dsName = "data.cherryservers_teams.all"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: teamsListConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dsName, "teams.0.id"),
+					resource.TestCheckResourceAttrSet(dsName, "teams.0.name"),
+					resource.TestCheckResourceAttrSet(dsName, "teams.0.type"),
+				),
+			},
+		},
+	})
+}
+
+const teamsListConfig string = `
+
+data "cherryservers_teams" "all" {
+}
+`
@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &teamListDS{}
+	_ datasource.DataSourceWithConfigure = &teamListDS{}
+)
+
+func NewTeamListDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &teamListDS{configurator: configurator}
+	}
+}
+
+type teamListDS struct {
+	configurator
+}
+
+type teamListModel struct {
+	Teams types.List `tfsdk:"teams"`
+}
+
+func (m *teamListModel) populateState(ctx context.Context, teams []cherrygo.Team) diag.Diagnostics {
+	teamModels := make([]teamModel, len(teams))
+	var diags diag.Diagnostics
+
+	for i, v := range teams {
+		diags.Append(teamModels[i].populateState(ctx, v)...)
+	}
+
+	list, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: teamAttributeTypes()}, teamModels)
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	m.Teams = list
+	return diags
+}
+
+func (d *teamListDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_teams"
+}
+
+func (d *teamListDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers teams data source. This can be used to read the teams available to the API token.",
+
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamSchema(true),
+				},
+				Computed:    true,
+				Description: "Available teams.",
+			},
+		},
+	}
+}
+
+func (d *teamListDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state teamListModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teams, _, err := d.Client().Teams.List(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("teams list failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.populateState(ctx, teams)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
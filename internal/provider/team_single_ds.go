@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource                     = &teamSingleDS{}
+	_ datasource.DataSourceWithConfigure        = &teamSingleDS{}
+	_ datasource.DataSourceWithConfigValidators = &teamSingleDS{}
+)
+
+func NewTeamSingleDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &teamSingleDS{configurator: configurator}
+	}
+}
+
+type teamSingleDS struct {
+	configurator
+}
+
+func (d *teamSingleDS) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(path.MatchRoot("name"), path.MatchRoot("id")),
+	}
+}
+
+func (d *teamSingleDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_team"
+}
+
+func (d *teamSingleDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers team data source. This can be used to look up a team by ID or name.",
+		Attributes:  teamSchema(false),
+	}
+}
+
+func (d *teamSingleDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state teamModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	team, err := state.findTeam(d.Client())
+	if err != nil {
+		resp.Diagnostics.AddError("team read failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.populateState(ctx, team)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
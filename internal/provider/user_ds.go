@@ -0,0 +1,129 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/cherryservers/cherrygo/v3"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &userDS{}
+	_ datasource.DataSourceWithConfigure = &userDS{}
+)
+
+func NewUserDS(configurator configurator) func() datasource.DataSource {
+	return func() datasource.DataSource {
+		return &userDS{configurator: configurator}
+	}
+}
+
+type userDS struct {
+	configurator
+}
+
+type userModel struct {
+	ID        types.Int64  `tfsdk:"id"`
+	Email     types.String `tfsdk:"email"`
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Href      types.String `tfsdk:"href"`
+	Teams     types.List   `tfsdk:"teams"`
+}
+
+func (m *userModel) populateState(ctx context.Context, user cherrygo.User, teams []cherrygo.Team) diag.Diagnostics {
+	m.ID = types.Int64Value(int64(user.ID))
+	m.Email = types.StringValue(user.Email)
+	m.FirstName = types.StringValue(user.FirstName)
+	m.LastName = types.StringValue(user.LastName)
+	m.Href = types.StringValue(user.Href)
+
+	var teamList teamListModel
+	diags := teamList.populateState(ctx, teams)
+	m.Teams = teamList.Teams
+
+	return diags
+}
+
+func (d *userDS) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user"
+}
+
+func (d *userDS) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		Description: "Provides a CherryServers user data source. This can be used to read the user authenticated by the provider API token.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				Computed:    true,
+				Description: "User ID.",
+			},
+			"email": schema.StringAttribute{
+				Computed:    true,
+				Description: "User email address.",
+			},
+			"first_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "User first name.",
+			},
+			"last_name": schema.StringAttribute{
+				Computed:    true,
+				Description: "User last name.",
+			},
+			"href": schema.StringAttribute{
+				Computed:    true,
+				Description: "The API link to the user.",
+			},
+			"teams": schema.ListNestedAttribute{
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: teamSchema(true),
+				},
+				Computed:    true,
+				Description: "Teams the user belongs to.",
+			},
+		},
+	}
+}
+
+func (d *userDS) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state userModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, _, err := d.Client().Users.CurrentUser(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("user read failed", err.Error())
+		return
+	}
+
+	// The user object does not include team membership,
+	// so the teams visible to the API token are listed instead.
+	teams, _, err := d.Client().Teams.List(nil)
+	if err != nil {
+		resp.Diagnostics.AddError("teams list failed", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(state.populateState(ctx, user, teams)...)
+
+	// Write logs using the tflog package
+	tflog.Trace(ctx, "read a data source")
+
+	// Save data into Terraform state
+	diags := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
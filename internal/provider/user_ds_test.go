@@ -0,0 +1,33 @@
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserDS(t *testing.T) {
+	const dsName = "data.cherryservers_user.current"
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: userConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr(dsName, "id", regexp.MustCompile("[0-9]+")),
+					resource.TestMatchResourceAttr(dsName, "email", regexp.MustCompile("@")),
+					resource.TestCheckResourceAttrSet(dsName, "teams.0.id"),
+				),
+			},
+		},
+	})
+}
+
+const userConfig string = `
+
+data "cherryservers_user" "current" {
+}
+`